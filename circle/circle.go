@@ -16,10 +16,11 @@
 // # Features
 //
 //   - Creation of circles from coordinates or points.
-//   - Type conversion to different numeric representations.
 //   - Relationship checks with points, including containment and intersection.
 //   - Support for geometric transformations such as translation, rotation, and scaling.
 //   - Efficient rasterization using Bresenham's circle algorithm.
+//   - Circular sectors ("pie slices"), with area and point containment checks.
+//   - Least-squares fitting of a circle to a set of points, with FitToPoints.
 //
 // This package is part of the geom2d library and integrates with other geometric primitives
 // such as points, line segments, and rectangles.
@@ -31,6 +32,7 @@ import (
 	"github.com/mikenye/geom2d"
 	"github.com/mikenye/geom2d/numeric"
 	"github.com/mikenye/geom2d/point"
+	"github.com/mikenye/geom2d/rectangle"
 	"github.com/mikenye/geom2d/types"
 	"math"
 )
@@ -83,6 +85,23 @@ func (c Circle) Area() float64 {
 	return math.Pi * c.radius * c.radius
 }
 
+// BoundingBox calculates the axis-aligned bounding box of the circle.
+//
+// Returns:
+//   - [rectangle.Rectangle]: The square centered on the circle's center, with sides of length 2 * radius.
+//
+// Notes:
+//   - This method allows [Circle] to satisfy the [rectangle.Bounded] interface.
+//   - A circle with a radius of zero produces a degenerate rectangle at the circle's center.
+func (c Circle) BoundingBox() rectangle.Rectangle {
+	return rectangle.New(
+		c.center.X()-c.radius,
+		c.center.Y()-c.radius,
+		c.center.X()+c.radius,
+		c.center.Y()+c.radius,
+	)
+}
+
 // Bresenham generates all points on the perimeter of a circle using Bresenham's circle-drawing algorithm.
 //
 // This method is typically used for rasterized circle rendering.
@@ -156,6 +175,17 @@ func (c Circle) Circumference() float64 {
 	return 2 * math.Pi * c.radius
 }
 
+// Perimeter calculates the perimeter of the circle.
+//
+// This is equivalent to [Circle.Circumference], and is provided so that [Circle] shares the
+// same method set as the other geometric types.
+//
+// Returns:
+//   - float64: The perimeter of the circle, computed as 2 * π * radius.
+func (c Circle) Perimeter() float64 {
+	return c.Circumference()
+}
+
 // RelationshipToPoint determines the spatial relationship between the Circle and a [point.Point].
 //
 // This function evaluates whether the point lies outside, on the boundary of, or inside the given circle.
@@ -195,6 +225,59 @@ func (c Circle) RelationshipToPoint(p point.Point) types.Relationship {
 	}
 }
 
+// RelationshipToRectangle determines the spatial relationship between the Circle and a [rectangle.Rectangle].
+//
+// The possible relationships are:
+//   - [types.RelationshipDisjoint]: The circle and rectangle do not touch.
+//   - [types.RelationshipIntersection]: The boundaries of the circle and rectangle cross or touch,
+//     and neither is contained by the other.
+//   - [types.RelationshipContainedBy]: The rectangle lies entirely within the circle.
+//   - [types.RelationshipContains]: The circle lies entirely within the rectangle.
+//
+// Parameters:
+//   - r (rectangle.Rectangle): The rectangle to compare with the current Circle.
+//
+// Returns:
+//   - [types.Relationship]: The relationship of the rectangle to the circle, as for [Circle.RelationshipToPoint].
+//
+// Behavior:
+//   - The circle and rectangle are disjoint if the closest point on the rectangle to the circle's center
+//     is farther from the center than the radius.
+//   - The rectangle contains the circle if the circle's bounding box lies within the rectangle.
+//   - The rectangle is contained by the circle if all four corners of the rectangle lie within the circle.
+//   - Boundaries touching from inside do not prevent containment, so a square containing its inscribed circle
+//     is [types.RelationshipContains]. Boundaries touching from outside are an intersection.
+//   - A circle and rectangle can never be equal, so [types.RelationshipEqual] is never returned.
+//
+// Notes:
+//   - Like [Circle.RelationshipToPoint], comparisons are exact, without epsilon tolerance.
+//   - As the rectangle package cannot depend on this package, there is no Rectangle.RelationshipToCircle.
+//     Use [types.Relationship.FlipContainment] on the result to obtain the relationship of the circle to the rectangle.
+func (c Circle) RelationshipToRectangle(r rectangle.Rectangle) types.Relationship {
+	bottomLeft, bottomRight, topRight, topLeft := r.Contour()
+	radiusSquared := c.radius * c.radius
+
+	closest := point.New(
+		max(bottomLeft.X(), min(topRight.X(), c.center.X())),
+		max(bottomLeft.Y(), min(topRight.Y(), c.center.Y())),
+	)
+	if closest.DistanceSquaredToPoint(c.center) > radiusSquared {
+		return types.RelationshipDisjoint
+	}
+
+	if c.center.X()-c.radius >= bottomLeft.X() && c.center.X()+c.radius <= topRight.X() &&
+		c.center.Y()-c.radius >= bottomLeft.Y() && c.center.Y()+c.radius <= topRight.Y() {
+		return types.RelationshipContains
+	}
+
+	for _, corner := range []point.Point{bottomLeft, bottomRight, topRight, topLeft} {
+		if corner.DistanceSquaredToPoint(c.center) > radiusSquared {
+			return types.RelationshipIntersection
+		}
+	}
+	return types.RelationshipContainedBy
+}
+
 // Eq determines whether the calling Circle (c) is equal to another Circle (other)
 // using the global epsilon value for approximate comparison.
 //
@@ -221,6 +304,42 @@ func (c Circle) Eq(other Circle) bool {
 	return centersEqual && radiiEqual
 }
 
+// Map applies a coordinate transformation function to the Circle.
+//
+// Parameters:
+//   - fn (func(point.Point) point.Point): The transformation to apply.
+//
+// Returns:
+//   - Circle: A new circle with the transformed center, and a radius taken from the transformed circumference.
+//   - error: An error if the transformation does not map the circle to a circle.
+//
+// Behavior:
+//   - The center is transformed, along with two points on the circumference in orthogonal directions from it.
+//     The new radius is the distance from the transformed center to the transformed circumference points.
+//   - Transformations that preserve shape, such as translation, rotation, reflection and uniform scaling,
+//     succeed. Transformations such as non-uniform scaling or shear, which would turn the circle into an
+//     ellipse, leave the two transformed radii unequal or no longer orthogonal, and return an error.
+//     For those, approximate the circle with [Circle.ToPolygon] and map each vertex instead.
+//   - The global epsilon value, scaled by the size of the transformed radii, is used for both checks.
+//
+// Notes:
+//   - Only three points are sampled, so fn is assumed to be an affine transformation. The result is not
+//     meaningful for transformations that bend straight lines.
+func (c Circle) Map(fn func(point.Point) point.Point) (Circle, error) {
+	center := fn(c.center)
+	a := fn(c.center.Add(point.New(c.radius, 0))).Sub(center)
+	b := fn(c.center.Add(point.New(0, c.radius))).Sub(center)
+
+	radiusA, radiusB := math.Hypot(a.X(), a.Y()), math.Hypot(b.X(), b.Y())
+	tolerance := geom2d.GetEpsilon() * max(1, radiusA, radiusB)
+	if !numeric.FloatEquals(radiusA, radiusB, tolerance) ||
+		!numeric.FloatEquals(a.DotProduct(b), 0, tolerance*max(radiusA, radiusB)) {
+		return Circle{}, fmt.Errorf("transformation does not map %s to a circle: orthogonal radii became %v and %v", c, a, b)
+	}
+
+	return NewFromPoint(center, (radiusA+radiusB)/2), nil
+}
+
 // MarshalJSON serializes Circle as JSON while preserving its original type.
 func (c Circle) MarshalJSON() ([]byte, error) {
 	return json.Marshal(struct {
@@ -283,6 +402,48 @@ func (c Circle) Scale(factor float64) Circle {
 	return Circle{center: c.center, radius: math.Abs(c.radius * factor)}
 }
 
+// SegmentsForMaxChordError calculates the fewest segments needed for a polygon inscribed in the Circle
+// (see [Circle.ToPolygon]) to stay within a given distance of the true circle.
+//
+// Parameters:
+//   - maxError (float64): The maximum allowed distance between each chord of the polygon and the circle's arc.
+//
+// Returns:
+//   - int: The number of segments required, which is never less than 3.
+//   - error: An error if maxError is not positive.
+//
+// Behavior:
+//   - The deviation of a chord from its arc is r * (1 - cos(π / n)) for n segments, so the result is the smallest
+//     n for which this does not exceed maxError.
+func (c Circle) SegmentsForMaxChordError(maxError float64) (int, error) {
+	if !(maxError > 0) {
+		return 0, fmt.Errorf("maxError must be positive, got %v", maxError)
+	}
+	if maxError >= c.radius {
+		return 3, nil
+	}
+	n := int(math.Ceil(math.Pi / math.Acos(1-maxError/c.radius)))
+	return max(3, n), nil
+}
+
+// StringWithPrecision returns a string representation of the Circle in the same format as [Circle.String],
+// with the center coordinates and radius printed using the given number of decimal places.
+//
+// Parameters:
+//   - decimals (int): The number of digits after the decimal point. If negative, the shortest
+//     representation that exactly represents each value is used.
+//
+// Returns:
+//   - string: A string representation of the Circle, such as "(1.0,2.0; r=0.5)".
+func (c Circle) StringWithPrecision(decimals int) string {
+	return fmt.Sprintf(
+		"(%s,%s; r=%s)",
+		numeric.FormatFloat(c.center.X(), decimals),
+		numeric.FormatFloat(c.center.Y(), decimals),
+		numeric.FormatFloat(c.radius, decimals),
+	)
+}
+
 // String returns a string representation of the Circle, including its center coordinates and radius.
 // This is useful for debugging and logging.
 //
@@ -294,6 +455,29 @@ func (c Circle) String() string {
 	return fmt.Sprintf("(%f,%f; r=%f)", c.center.X(), c.center.Y(), c.radius)
 }
 
+// ToPolygon approximates the Circle with a regular polygon.
+//
+// This allows a circle to be used where a polygonal shape is required.
+//
+// Parameters:
+//   - segments (int): The number of sides of the polygon. Use [Circle.SegmentsForMaxChordError] to choose
+//     this from a maximum allowed deviation.
+//   - circumscribed (bool): If false, the polygon's vertices lie on the circle (it is inscribed), so the
+//     polygon lies within the circle. If true, the polygon's edges touch the circle (it is circumscribed),
+//     so the circle lies within the polygon.
+//
+// Returns:
+//   - []point.Point: The vertices of the polygon in counterclockwise order, starting on the positive
+//     X-axis from the center. The first point is not repeated at the end.
+//   - error: An error if segments is less than three or the circle has zero radius.
+func (c Circle) ToPolygon(segments int, circumscribed bool) ([]point.Point, error) {
+	radius := c.radius
+	if circumscribed && segments >= 3 {
+		radius /= math.Cos(math.Pi / float64(segments))
+	}
+	return point.RegularPolygon(c.center, radius, segments, 0)
+}
+
 // Translate moves the circle by a specified vector (given as a [point.Point]).
 //
 // This method shifts the circle's center by the given vector v, effectively
@@ -329,6 +513,27 @@ func (c *Circle) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// WithinDistance reports whether a point lies within a given distance of the Circle.
+//
+// The Circle is treated as a solid disk, so any point inside the Circle is within distance 0 of it,
+// and a point outside is within d of it if it is no more than d from the circumference.
+// Squared distances are compared to avoid computing a square root.
+//
+// Parameters:
+//   - p (point.Point): The point to test.
+//   - d (float64): The maximum distance from the Circle.
+//
+// Returns:
+//   - bool: true if the distance from p to the Circle is less than or equal to d, false otherwise.
+//
+// Behavior:
+//   - The boundary is included: a point exactly d away from the circumference is within the distance.
+//   - The comparison is exact, without epsilon tolerance.
+//   - If d is negative, false is returned.
+func (c Circle) WithinDistance(p point.Point, d float64) bool {
+	return d >= 0 && c.center.WithinDistance(p, c.radius+d)
+}
+
 // reflectAcrossCircleOctants generates a slice of points that represent the reflection
 // of a given point (x, y) across all eight octants of a circle centered at (xc, yc).
 //
@@ -3,7 +3,9 @@ package circle
 import (
 	"encoding/json"
 	"github.com/mikenye/geom2d"
+	"github.com/mikenye/geom2d/linesegment"
 	"github.com/mikenye/geom2d/point"
+	"github.com/mikenye/geom2d/rectangle"
 	"github.com/mikenye/geom2d/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -37,6 +39,33 @@ func TestCircle_Area(t *testing.T) {
 	}
 }
 
+func TestCircle_BoundingBox(t *testing.T) {
+	tests := map[string]struct {
+		circle   Circle
+		expected rectangle.Rectangle
+	}{
+		"circle at origin": {
+			circle:   New(0, 0, 5),
+			expected: rectangle.New(-5, -5, 5, 5),
+		},
+		"offset circle": {
+			circle:   New(3, 4, 1.5),
+			expected: rectangle.New(1.5, 2.5, 4.5, 5.5),
+		},
+		"zero radius": {
+			circle:   New(2, 2, 0),
+			expected: rectangle.New(2, 2, 2, 2),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			var bounded rectangle.Bounded = tc.circle
+			assert.True(t, tc.expected.Eq(bounded.BoundingBox()), "expected %s, got %s", tc.expected, bounded.BoundingBox())
+		})
+	}
+}
+
 func TestCircle_Bresenham(t *testing.T) {
 	tests := map[string]struct {
 		center   point.Point
@@ -190,6 +219,68 @@ func TestCircle_Eq(t *testing.T) {
 	}
 }
 
+func TestCircle_Map(t *testing.T) {
+	tests := map[string]struct {
+		circle   Circle
+		fn       func(point.Point) point.Point
+		expected Circle
+	}{
+		"rotation": {
+			circle:   New(1, 2, 3),
+			fn:       func(p point.Point) point.Point { return p.Rotate(point.Origin(), math.Pi/2) },
+			expected: New(-2, 1, 3),
+		},
+		"translation": {
+			circle:   New(1, 2, 3),
+			fn:       func(p point.Point) point.Point { return p.Translate(point.New(-4, 5)) },
+			expected: New(-3, 7, 3),
+		},
+		"reflection": {
+			circle:   New(1, 2, 3),
+			fn:       func(p point.Point) point.Point { return point.New(-p.X(), p.Y()) },
+			expected: New(-1, 2, 3),
+		},
+		"uniform scale": {
+			circle:   New(1, 2, 1),
+			fn:       func(p point.Point) point.Point { return p.Scale(point.Origin(), 2) },
+			expected: New(2, 4, 2),
+		},
+		"large circle rotated by an arbitrary angle": {
+			circle:   New(1e6, -2e6, 5e5),
+			fn:       func(p point.Point) point.Point { return p.Rotate(point.New(3, 4), 0.7) },
+			expected: NewFromPoint(point.New(1e6, -2e6).Rotate(point.New(3, 4), 0.7), 5e5),
+		},
+		"zero radius": {
+			circle:   New(1, 2, 0),
+			fn:       func(p point.Point) point.Point { return point.New(p.X()*2, p.Y()*3) },
+			expected: New(2, 6, 0),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			actual, err := tc.circle.Map(tc.fn)
+			require.NoError(t, err)
+			assert.True(t, tc.expected.Center().Eq(actual.Center()), "expected %s, got %s", tc.expected, actual)
+			assert.InDelta(t, tc.expected.Radius(), actual.Radius(), geom2d.GetEpsilon()*max(1, tc.expected.Radius()))
+		})
+	}
+}
+
+func TestCircle_Map_Errors(t *testing.T) {
+	tests := map[string]func(point.Point) point.Point{
+		"non-uniform scale": func(p point.Point) point.Point { return point.New(p.X()*2, p.Y()) },
+		"shear":             func(p point.Point) point.Point { return point.New(p.X()+p.Y(), p.Y()) },
+	}
+
+	for name, fn := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := New(1, 2, 3).Map(fn)
+			assert.Error(t, err)
+		})
+	}
+}
+
 func TestCircle_MarshalUnmarshalJSON(t *testing.T) {
 	tests := map[string]struct {
 		circle   Circle // Input circle
@@ -247,6 +338,12 @@ func TestCircle_Radius(t *testing.T) {
 	}
 }
 
+func TestCircle_Perimeter(t *testing.T) {
+	c := New(1, 1, 3)
+	assert.InDelta(t, c.Circumference(), c.Perimeter(), geom2d.GetEpsilon())
+	assert.InDelta(t, 6*math.Pi, c.Perimeter(), geom2d.GetEpsilon())
+}
+
 func TestCircle_RelationshipToPoint(t *testing.T) {
 	testCases := map[string]struct {
 		point       point.Point
@@ -277,6 +374,34 @@ func TestCircle_RelationshipToPoint(t *testing.T) {
 	}
 }
 
+func TestCircle_RelationshipToRectangle(t *testing.T) {
+	square := rectangle.New(0, 0, 10, 10)
+
+	tests := map[string]struct {
+		circle   Circle
+		rect     rectangle.Rectangle
+		expected types.Relationship
+	}{
+		"disjoint beside":               {circle: New(15, 5, 2), rect: square, expected: types.RelationshipDisjoint},
+		"disjoint near corner":          {circle: New(12, 12, 2), rect: square, expected: types.RelationshipDisjoint},
+		"touching edge from outside":    {circle: New(12, 5, 2), rect: square, expected: types.RelationshipIntersection},
+		"touching corner from outside":  {circle: New(13, 14, 5), rect: square, expected: types.RelationshipIntersection},
+		"overlapping edge":              {circle: New(10, 5, 2), rect: square, expected: types.RelationshipIntersection},
+		"overlapping corner":            {circle: New(11, 11, 2), rect: square, expected: types.RelationshipIntersection},
+		"circle inside rectangle":       {circle: New(5, 5, 2), rect: square, expected: types.RelationshipContains},
+		"circle inscribed in rectangle": {circle: New(5, 5, 5), rect: square, expected: types.RelationshipContains},
+		"rectangle inside circle":       {circle: New(5, 5, 10), rect: square, expected: types.RelationshipContainedBy},
+		"rectangle inscribed in circle": {circle: New(3, 4, 5), rect: rectangle.New(0, 0, 6, 8), expected: types.RelationshipContainedBy},
+		"circle crosses all edges":      {circle: New(5, 5, 6), rect: square, expected: types.RelationshipIntersection},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.circle.RelationshipToRectangle(tc.rect))
+		})
+	}
+}
+
 func TestCircle_Rotate(t *testing.T) {
 	tests := map[string]struct {
 		circle   Circle
@@ -374,6 +499,48 @@ func TestCircle_Scale(t *testing.T) {
 	}
 }
 
+func TestCircle_SegmentsForMaxChordError(t *testing.T) {
+	tests := map[string]struct {
+		circle      Circle
+		maxError    float64
+		expected    int
+		expectedErr bool
+	}{
+		"hexagon deviation": {
+			circle:   New(0, 0, 2),
+			maxError: 0.3, // hexagon deviates by 0.268, pentagon by 0.382
+			expected: 6,
+		},
+		"small error needs many segments": {
+			circle:   New(0, 0, 100),
+			maxError: 0.01,
+			expected: 223,
+		},
+		"large error clamps to three": {
+			circle:   New(0, 0, 1),
+			maxError: 5,
+			expected: 3,
+		},
+		"zero error": {
+			circle:      New(0, 0, 1),
+			maxError:    0,
+			expectedErr: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			actual, err := tc.circle.SegmentsForMaxChordError(tc.maxError)
+			if tc.expectedErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
 func TestCircle_String(t *testing.T) {
 	tests := map[string]struct {
 		circle   Circle
@@ -401,6 +568,70 @@ func TestCircle_String(t *testing.T) {
 	}
 }
 
+func TestCircle_StringWithPrecision(t *testing.T) {
+	tests := map[string]struct {
+		circle   Circle
+		decimals int
+		expected string
+	}{
+		"two decimals": {
+			circle:   New(3.14159, -4.5, 5.5),
+			decimals: 2,
+			expected: "(3.14,-4.50; r=5.50)",
+		},
+		"zero decimals": {
+			circle:   New(0, 0, 1.25),
+			decimals: 0,
+			expected: "(0,0; r=1)",
+		},
+		"negative decimals uses shortest representation": {
+			circle:   New(3.5, 4, 0.1),
+			decimals: -1,
+			expected: "(3.5,4; r=0.1)",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.circle.StringWithPrecision(tc.decimals))
+		})
+	}
+}
+
+func TestCircle_ToPolygon(t *testing.T) {
+	t.Run("inscribed", func(t *testing.T) {
+		c := New(1, 2, 3)
+		polygon, err := c.ToPolygon(8, false)
+		require.NoError(t, err)
+		require.Len(t, polygon, 8)
+		assert.True(t, point.New(4, 2).Eq(polygon[0]), "first vertex should be on the positive X-axis, got %s", polygon[0])
+		for _, p := range polygon {
+			assert.InDelta(t, 3, p.DistanceToPoint(c.Center()), geom2d.GetEpsilon())
+		}
+	})
+
+	t.Run("circumscribed", func(t *testing.T) {
+		c := New(0, 0, 1)
+		polygon, err := c.ToPolygon(4, true)
+		require.NoError(t, err)
+		require.Len(t, polygon, 4)
+		for i, p := range polygon {
+			edge := linesegment.NewFromPoints(p, polygon[(i+1)%len(polygon)])
+			assert.InDelta(t, 1, edge.DistanceToPoint(c.Center()), geom2d.GetEpsilon(), "edge %s should touch circle", edge)
+		}
+	})
+
+	t.Run("fewer than three segments", func(t *testing.T) {
+		_, err := New(0, 0, 1).ToPolygon(2, false)
+		assert.Error(t, err)
+	})
+
+	t.Run("zero radius", func(t *testing.T) {
+		_, err := New(0, 0, 0).ToPolygon(6, true)
+		assert.Error(t, err)
+	})
+}
+
 func TestCircle_Translate(t *testing.T) {
 	tests := map[string]struct {
 		circle   Circle
@@ -433,6 +664,28 @@ func TestCircle_Translate(t *testing.T) {
 	}
 }
 
+func TestCircle_WithinDistance(t *testing.T) {
+	tests := map[string]struct {
+		circle   Circle
+		p        point.Point
+		d        float64
+		expected bool
+	}{
+		"inside the circle":            {circle: New(0, 0, 5), p: point.New(1, 1), d: 0, expected: true},
+		"on the circumference":         {circle: New(0, 0, 5), p: point.New(3, 4), d: 0, expected: true},
+		"outside, within distance":     {circle: New(0, 0, 5), p: point.New(6, 8), d: 6, expected: true},
+		"outside, exactly at distance": {circle: New(0, 0, 5), p: point.New(6, 8), d: 5, expected: true},
+		"outside, beyond distance":     {circle: New(0, 0, 5), p: point.New(6, 8), d: 4.999, expected: false},
+		"negative distance":            {circle: New(0, 0, 5), p: point.New(1, 1), d: -1, expected: false},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.circle.WithinDistance(tc.p, tc.d))
+		})
+	}
+}
+
 func TestReflectAcrossCircleOctants(t *testing.T) {
 	tests := map[string]struct {
 		xc, yc, x, y float64
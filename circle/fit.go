@@ -0,0 +1,81 @@
+package circle
+
+import (
+	"fmt"
+	"github.com/mikenye/geom2d"
+	"github.com/mikenye/geom2d/point"
+	"math"
+)
+
+// FitToPoints finds the circle that best fits a set of points, using algebraic least squares.
+//
+// This is useful for reverse-engineering arcs and circles from scanned or measured data,
+// such as when importing CAD drawings or in metrology.
+//
+// Parameters:
+//   - points ([]point.Point): The points to fit. The slice is not modified.
+//
+// Returns:
+//   - Circle: The best-fit circle.
+//   - float64: The root-mean-square of the distances from each point to the circumference of the circle.
+//     This is 0 if every point lies exactly on the circle.
+//   - error: An error if fewer than three points are given, or if the points are collinear, in which
+//     case no circle fits them.
+//
+// Behavior:
+//   - The circle is found using the Kåsa method, which minimizes the sum of squared differences between
+//     the squared distance of each point from the center and the squared radius. This has a closed-form
+//     solution, and matches the geometric best fit closely when the points cover most of the circle.
+//   - For points covering only a short arc, the fitted radius tends to be slightly too small.
+//   - The points are centered on their mean before fitting, to improve numerical stability.
+//
+// Notes:
+//   - This is a different objective from the smallest circle enclosing all points.
+func FitToPoints(points []point.Point) (Circle, float64, error) {
+	n := len(points)
+	if n < 3 {
+		return Circle{}, 0, fmt.Errorf("at least 3 points are required to fit a circle, got %d", n)
+	}
+
+	var meanX, meanY float64
+	for _, p := range points {
+		meanX += p.X()
+		meanY += p.Y()
+	}
+	meanX /= float64(n)
+	meanY /= float64(n)
+
+	// sums over the centered coordinates (u, v)
+	var suu, suv, svv, suuu, suvv, svvv, svuu float64
+	for _, p := range points {
+		u, v := p.X()-meanX, p.Y()-meanY
+		suu += u * u
+		suv += u * v
+		svv += v * v
+		suuu += u * u * u
+		suvv += u * v * v
+		svvv += v * v * v
+		svuu += v * u * u
+	}
+
+	// solve the 2x2 normal equations for the center (uc, vc) by Cramer's rule
+	det := suu*svv - suv*suv
+	if math.Abs(det) <= geom2d.GetEpsilon()*(suu*svv+suv*suv) {
+		return Circle{}, 0, fmt.Errorf("cannot fit a circle: the %d points are collinear", n)
+	}
+	bu := (suuu + suvv) / 2
+	bv := (svvv + svuu) / 2
+	uc := (bu*svv - bv*suv) / det
+	vc := (bv*suu - bu*suv) / det
+
+	center := point.New(uc+meanX, vc+meanY)
+	radius := math.Sqrt(uc*uc + vc*vc + (suu+svv)/float64(n))
+
+	var sumSquaredResiduals float64
+	for _, p := range points {
+		residual := p.DistanceToPoint(center) - radius
+		sumSquaredResiduals += residual * residual
+	}
+
+	return NewFromPoint(center, radius), math.Sqrt(sumSquaredResiduals / float64(n)), nil
+}
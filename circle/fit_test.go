@@ -0,0 +1,84 @@
+package circle
+
+import (
+	"github.com/mikenye/geom2d/point"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"math"
+	"testing"
+)
+
+func TestFitToPoints(t *testing.T) {
+	tests := map[string]struct {
+		points   []point.Point
+		expected Circle
+	}{
+		"three points on unit circle": {
+			points:   []point.Point{point.New(1, 0), point.New(0, 1), point.New(-1, 0)},
+			expected: New(0, 0, 1),
+		},
+		"points on an offset circle": {
+			points: []point.Point{
+				point.New(13, 20), point.New(3, 30), point.New(-7, 20), point.New(3, 10),
+				point.New(9, 28), point.New(-3, 12),
+			},
+			expected: New(3, 20, 10),
+		},
+		"points on a short arc": {
+			points: []point.Point{
+				point.NewFromPolar(point.New(100, -50), 25, 0.1),
+				point.NewFromPolar(point.New(100, -50), 25, 0.3),
+				point.NewFromPolar(point.New(100, -50), 25, 0.5),
+				point.NewFromPolar(point.New(100, -50), 25, 0.7),
+			},
+			expected: New(100, -50, 25),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			actual, residual, err := FitToPoints(tc.points)
+			require.NoError(t, err)
+			assert.InDelta(t, tc.expected.Center().X(), actual.Center().X(), 1e-9)
+			assert.InDelta(t, tc.expected.Center().Y(), actual.Center().Y(), 1e-9)
+			assert.InDelta(t, tc.expected.Radius(), actual.Radius(), 1e-9)
+			assert.InDelta(t, 0, residual, 1e-9)
+		})
+	}
+}
+
+func TestFitToPoints_Noisy(t *testing.T) {
+	// points alternately just inside and just outside a circle of radius 5 centered at (1, 2)
+	points := make([]point.Point, 0, 12)
+	for i := 0; i < 12; i++ {
+		radius := 5.1
+		if i%2 == 1 {
+			radius = 4.9
+		}
+		points = append(points, point.NewFromPolar(point.New(1, 2), radius, 2*math.Pi*float64(i)/12))
+	}
+
+	actual, residual, err := FitToPoints(points)
+	require.NoError(t, err)
+	assert.InDelta(t, 1, actual.Center().X(), 1e-9)
+	assert.InDelta(t, 2, actual.Center().Y(), 1e-9)
+	assert.InDelta(t, 5, actual.Radius(), 0.01)
+	assert.InDelta(t, 0.1, residual, 0.01)
+}
+
+func TestFitToPoints_Errors(t *testing.T) {
+	tests := map[string][]point.Point{
+		"no points":     nil,
+		"two points":    {point.New(0, 0), point.New(1, 1)},
+		"collinear":     {point.New(0, 0), point.New(1, 1), point.New(2, 2), point.New(3, 3)},
+		"vertical line": {point.New(1, 0), point.New(1, 1), point.New(1, 2)},
+		"all the same":  {point.New(1, 1), point.New(1, 1), point.New(1, 1)},
+	}
+
+	for name, points := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, _, err := FitToPoints(points)
+			assert.Error(t, err)
+		})
+	}
+}
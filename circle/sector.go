@@ -0,0 +1,123 @@
+package circle
+
+import (
+	"github.com/mikenye/geom2d"
+	"github.com/mikenye/geom2d/numeric"
+	"github.com/mikenye/geom2d/point"
+	"math"
+)
+
+// Sector represents a circular sector (a "pie slice") in 2D space, bounded by two radii of a [Circle] and the arc
+// between them.
+//
+// The sector extends counterclockwise from its start angle to its end angle, with angles measured in radians
+// counterclockwise from the positive X-axis. Sectors are useful for field-of-view and radar-style queries.
+//
+// A Sector is created using [Circle.Sector].
+type Sector struct {
+	circle     Circle  // The circle the sector is a part of
+	startAngle float64 // The start angle in radians, normalized to [0, 2π)
+	sweep      float64 // The counterclockwise angle from start to end in radians, in [0, 2π]
+}
+
+// Sector returns the [Sector] of the Circle extending counterclockwise from startAngle to endAngle.
+//
+// Parameters:
+//   - startAngle (float64): The angle of the first bounding radius, in radians counterclockwise from the positive X-axis.
+//   - endAngle (float64): The angle of the second bounding radius, in radians counterclockwise from the positive X-axis.
+//
+// Returns:
+//   - Sector: The sector of the circle between the two angles.
+//
+// Behavior:
+//   - Angles may be given in any range, and are normalized. The sector always extends counterclockwise
+//     from startAngle to endAngle, so a sector may cross the 0/2π boundary, for example from 3π/2 to π/2.
+//   - If startAngle and endAngle are equal, the sector is empty (a single radius). If they differ by a non-zero
+//     multiple of 2π, the sector is the full circle.
+func (c Circle) Sector(startAngle, endAngle float64) Sector {
+	sweep := normalizeAngle(endAngle - startAngle)
+	if sweep == 0 && endAngle != startAngle {
+		sweep = 2 * math.Pi
+	}
+	return Sector{
+		circle:     c,
+		startAngle: normalizeAngle(startAngle),
+		sweep:      sweep,
+	}
+}
+
+// Area calculates the area of the sector.
+//
+// Returns:
+//   - float64: The area of the sector, computed as ½ * radius² * θ, where θ is the angle swept by the sector.
+func (s Sector) Area() float64 {
+	return 0.5 * s.circle.radius * s.circle.radius * s.sweep
+}
+
+// Circle returns the [Circle] that the sector is a part of.
+//
+// Returns:
+//   - Circle: The sector's circle.
+func (s Sector) Circle() Circle {
+	return s.circle
+}
+
+// ContainsPoint checks if a given point lies within or on the boundary of the Sector.
+//
+// Parameters:
+//   - p (point.Point): The point to check.
+//
+// Returns:
+//   - bool: true if the point is inside the sector or on one of its bounding radii or its arc, false otherwise.
+//
+// Behavior:
+//   - The point must lie within the radius of the circle, and its angle around the circle's center
+//     must be within the angular range of the sector, taking into account sectors that cross the 0/2π boundary.
+//   - The center of the circle is always contained.
+//   - The global epsilon value is used when comparing both the distance and the angle, to account for
+//     floating-point precision issues on the boundary.
+func (s Sector) ContainsPoint(p point.Point) bool {
+	epsilon := geom2d.GetEpsilon()
+
+	v := p.Sub(s.circle.center)
+	if !numeric.FloatLessThanOrEqualTo(v.DistanceToPoint(point.Origin()), s.circle.radius, epsilon) {
+		return false
+	}
+	if v.Eq(point.Origin()) {
+		return true
+	}
+
+	// angle of the point, relative to the start of the sector
+	angle := normalizeAngle(math.Atan2(v.Y(), v.X()) - s.startAngle)
+	return numeric.FloatLessThanOrEqualTo(angle, s.sweep, epsilon) ||
+		numeric.FloatEquals(angle, 2*math.Pi, epsilon) // just clockwise of the start radius
+}
+
+// EndAngle returns the angle of the sector's second bounding radius.
+//
+// Returns:
+//   - float64: The end angle in radians, counterclockwise from the positive X-axis.
+//     This is StartAngle plus the angle swept by the sector, so it may be up to 4π.
+func (s Sector) EndAngle() float64 {
+	return s.startAngle + s.sweep
+}
+
+// StartAngle returns the angle of the sector's first bounding radius.
+//
+// Returns:
+//   - float64: The start angle in radians, counterclockwise from the positive X-axis, in the range [0, 2π).
+func (s Sector) StartAngle() float64 {
+	return s.startAngle
+}
+
+// normalizeAngle maps an angle in radians to the range [0, 2π).
+func normalizeAngle(radians float64) float64 {
+	radians = math.Mod(radians, 2*math.Pi)
+	if radians < 0 {
+		radians += 2 * math.Pi
+	}
+	if radians >= 2*math.Pi {
+		radians = 0
+	}
+	return radians
+}
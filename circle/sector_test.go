@@ -0,0 +1,170 @@
+package circle
+
+import (
+	"github.com/mikenye/geom2d"
+	"github.com/mikenye/geom2d/point"
+	"github.com/stretchr/testify/assert"
+	"math"
+	"testing"
+)
+
+func TestCircle_Sector(t *testing.T) {
+	tests := map[string]struct {
+		startAngle, endAngle float64
+		expectedStart        float64
+		expectedEnd          float64
+	}{
+		"first quadrant": {
+			startAngle:    0,
+			endAngle:      math.Pi / 2,
+			expectedStart: 0,
+			expectedEnd:   math.Pi / 2,
+		},
+		"crossing zero": {
+			startAngle:    3 * math.Pi / 2,
+			endAngle:      math.Pi / 2,
+			expectedStart: 3 * math.Pi / 2,
+			expectedEnd:   5 * math.Pi / 2,
+		},
+		"negative start angle": {
+			startAngle:    -math.Pi / 4,
+			endAngle:      math.Pi / 4,
+			expectedStart: 7 * math.Pi / 4,
+			expectedEnd:   9 * math.Pi / 4,
+		},
+		"full circle": {
+			startAngle:    0,
+			endAngle:      2 * math.Pi,
+			expectedStart: 0,
+			expectedEnd:   2 * math.Pi,
+		},
+		"empty": {
+			startAngle:    1,
+			endAngle:      1,
+			expectedStart: 1,
+			expectedEnd:   1,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			s := New(0, 0, 1).Sector(tc.startAngle, tc.endAngle)
+			assert.InDelta(t, tc.expectedStart, s.StartAngle(), geom2d.GetEpsilon())
+			assert.InDelta(t, tc.expectedEnd, s.EndAngle(), geom2d.GetEpsilon())
+			assert.Equal(t, New(0, 0, 1), s.Circle())
+		})
+	}
+}
+
+func TestSector_Area(t *testing.T) {
+	tests := map[string]struct {
+		sector   Sector
+		expected float64
+	}{
+		"quarter circle": {
+			sector:   New(1, 1, 2).Sector(0, math.Pi/2),
+			expected: math.Pi,
+		},
+		"quarter circle crossing zero": {
+			sector:   New(1, 1, 2).Sector(7*math.Pi/4, math.Pi/4),
+			expected: math.Pi,
+		},
+		"three quarter circle": {
+			sector:   New(0, 0, 2).Sector(math.Pi/2, 0),
+			expected: 3 * math.Pi,
+		},
+		"full circle": {
+			sector:   New(0, 0, 2).Sector(0, 2*math.Pi),
+			expected: New(0, 0, 2).Area(),
+		},
+		"empty": {
+			sector:   New(0, 0, 2).Sector(1, 1),
+			expected: 0,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.InDelta(t, tc.expected, tc.sector.Area(), geom2d.GetEpsilon())
+		})
+	}
+}
+
+func TestSector_ContainsPoint(t *testing.T) {
+	firstQuadrant := New(0, 0, 10).Sector(0, math.Pi/2)
+	crossingZero := New(0, 0, 10).Sector(7*math.Pi/4, math.Pi/4)
+	offsetCenter := New(5, 5, 2).Sector(math.Pi/2, math.Pi)
+
+	tests := map[string]struct {
+		sector   Sector
+		point    point.Point
+		expected bool
+	}{
+		"inside first quadrant": {
+			sector:   firstQuadrant,
+			point:    point.New(3, 4),
+			expected: true,
+		},
+		"outside angular range": {
+			sector:   firstQuadrant,
+			point:    point.New(-3, 4),
+			expected: false,
+		},
+		"outside radius": {
+			sector:   firstQuadrant,
+			point:    point.New(8, 8),
+			expected: false,
+		},
+		"on arc": {
+			sector:   firstQuadrant,
+			point:    point.New(6, 8),
+			expected: true,
+		},
+		"on start radius": {
+			sector:   firstQuadrant,
+			point:    point.New(5, 0),
+			expected: true,
+		},
+		"on end radius": {
+			sector:   firstQuadrant,
+			point:    point.New(0, 5),
+			expected: true,
+		},
+		"center": {
+			sector:   firstQuadrant,
+			point:    point.New(0, 0),
+			expected: true,
+		},
+		"crossing zero, below X-axis": {
+			sector:   crossingZero,
+			point:    point.New(5, -1),
+			expected: true,
+		},
+		"crossing zero, above X-axis": {
+			sector:   crossingZero,
+			point:    point.New(5, 1),
+			expected: true,
+		},
+		"crossing zero, outside": {
+			sector:   crossingZero,
+			point:    point.New(0, 5),
+			expected: false,
+		},
+		"offset center, inside": {
+			sector:   offsetCenter,
+			point:    point.New(4, 6),
+			expected: true,
+		},
+		"offset center, outside angular range": {
+			sector:   offsetCenter,
+			point:    point.New(6, 6),
+			expected: false,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.sector.ContainsPoint(tc.point))
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package linesegment
+
+import (
+	"github.com/mikenye/geom2d/numeric"
+	"io"
+)
+
+// ReadSegmentsCSV reads a list of line segments from CSV data.
+//
+// Each row holds one line segment, as the coordinates of its two endpoints: "x1,y1,x2,y2". This is the format
+// written by [WriteSegmentsCSV], and is convenient for exchanging data with spreadsheets and tools such as numpy.
+//
+// Parameters:
+//   - r (io.Reader): The source of the CSV data.
+//
+// Returns:
+//   - []LineSegment: The line segments, in the order they were read.
+//   - error: An error if the data cannot be read, or if a row is malformed. The error includes the line number of the row.
+//
+// Behavior:
+//   - An optional header row (such as "x1,y1,x2,y2") is detected and skipped.
+//   - The endpoints may be given in either order; they are normalized as by [New].
+//   - See [numeric.ReadFloatCSV] for details of how rows are parsed.
+func ReadSegmentsCSV(r io.Reader) ([]LineSegment, error) {
+	rows, err := numeric.ReadFloatCSV(r, 4)
+	if err != nil {
+		return nil, err
+	}
+	segments := make([]LineSegment, len(rows))
+	for i, row := range rows {
+		segments[i] = New(row[0], row[1], row[2], row[3])
+	}
+	return segments, nil
+}
+
+// WriteSegmentsCSV writes a list of line segments as CSV data.
+//
+// Each line segment is written as one row holding the coordinates of its upper and then lower endpoint:
+// "x1,y1,x2,y2". No header row is written. The output can be read back using [ReadSegmentsCSV].
+//
+// Parameters:
+//   - w (io.Writer): The destination of the CSV data.
+//   - segments ([]LineSegment): The line segments to write.
+//
+// Returns:
+//   - error: An error if the data cannot be written.
+func WriteSegmentsCSV(w io.Writer, segments []LineSegment) error {
+	rows := make([][]float64, len(segments))
+	for i, l := range segments {
+		rows[i] = []float64{l.upper.X(), l.upper.Y(), l.lower.X(), l.lower.Y()}
+	}
+	return numeric.WriteFloatCSV(w, rows)
+}
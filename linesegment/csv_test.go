@@ -0,0 +1,54 @@
+package linesegment
+
+import (
+	"bytes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"strings"
+	"testing"
+)
+
+func TestReadSegmentsCSV(t *testing.T) {
+	tests := map[string]struct {
+		input       string
+		expected    []LineSegment
+		expectedErr string
+	}{
+		"with header": {
+			input:    "x1,y1,x2,y2\n0,0,1,1\n2,3,4,-5\n",
+			expected: []LineSegment{New(0, 0, 1, 1), New(2, 3, 4, -5)},
+		},
+		"without header": {
+			input:    "0,0,1,1\n",
+			expected: []LineSegment{New(0, 0, 1, 1)},
+		},
+		"malformed row": {
+			input:       "0,0,1,1\n0,0,1\n",
+			expectedErr: "line 2: expected 4 fields, got 3",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			actual, err := ReadSegmentsCSV(strings.NewReader(tc.input))
+			if tc.expectedErr != "" {
+				assert.EqualError(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestWriteSegmentsCSV(t *testing.T) {
+	segments := []LineSegment{New(0, 0, 1, 1), New(2, 3, 4, -5)}
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteSegmentsCSV(&buf, segments))
+	assert.Equal(t, "1,1,0,0\n2,3,4,-5\n", buf.String())
+
+	actual, err := ReadSegmentsCSV(&buf)
+	require.NoError(t, err)
+	assert.Equal(t, segments, actual)
+}
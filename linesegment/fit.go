@@ -0,0 +1,78 @@
+package linesegment
+
+import (
+	"fmt"
+	"github.com/mikenye/geom2d/point"
+	"math"
+)
+
+// FitToPoints finds the line segment that best fits a set of points, using total least squares.
+//
+// This is useful for trend lines and for fitting edges to measured or scanned data.
+//
+// Parameters:
+//   - points ([]point.Point): The points to fit. The slice is not modified.
+//
+// Returns:
+//   - LineSegment: The best-fit line, clipped to the extent of the points projected onto it.
+//   - float64: The root-mean-square of the perpendicular distances from each point to the line.
+//     This is 0 if every point lies exactly on the line.
+//   - error: An error if fewer than two distinct points are given.
+//
+// Behavior:
+//   - The line minimizes the sum of squared perpendicular (orthogonal) distances from the points, rather than the
+//     vertical distances minimized by ordinary least squares. This treats X and Y equally, so works just as well for
+//     vertical or near-vertical data.
+//   - The line passes through the mean of the points, in the direction of the principal eigenvector of their
+//     covariance matrix.
+//   - If the points have no preferred direction (for example, the corners of a square), every line through their mean
+//     fits equally well, and a horizontal line is returned.
+func FitToPoints(points []point.Point) (LineSegment, float64, error) {
+	distinct := false
+	for _, p := range points[min(1, len(points)):] {
+		if !p.Eq(points[0]) {
+			distinct = true
+			break
+		}
+	}
+	if !distinct {
+		return LineSegment{}, 0, fmt.Errorf("at least 2 distinct points are required to fit a line segment")
+	}
+
+	n := float64(len(points))
+	var meanX, meanY float64
+	for _, p := range points {
+		meanX += p.X()
+		meanY += p.Y()
+	}
+	mean := point.New(meanX/n, meanY/n)
+
+	var sxx, sxy, syy float64
+	for _, p := range points {
+		d := p.Sub(mean)
+		sxx += d.X() * d.X()
+		sxy += d.X() * d.Y()
+		syy += d.Y() * d.Y()
+	}
+
+	// angle of the principal eigenvector of the covariance matrix
+	theta := math.Atan2(2*sxy, sxx-syy) / 2
+	direction := point.New(math.Cos(theta), math.Sin(theta))
+	normal := point.New(-direction.Y(), direction.X())
+
+	tMin, tMax := math.Inf(1), math.Inf(-1)
+	var sumSquaredResiduals float64
+	for _, p := range points {
+		d := p.Sub(mean)
+		t := d.DotProduct(direction)
+		tMin, tMax = min(tMin, t), max(tMax, t)
+		residual := d.DotProduct(normal)
+		sumSquaredResiduals += residual * residual
+	}
+
+	segment := NewFromPoints(
+		mean.Add(direction.Scale(point.Origin(), tMin)),
+		mean.Add(direction.Scale(point.Origin(), tMax)),
+	)
+	return segment, math.Sqrt(sumSquaredResiduals / n), nil
+}
@@ -0,0 +1,80 @@
+package linesegment
+
+import (
+	"github.com/mikenye/geom2d/point"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestFitToPoints(t *testing.T) {
+	tests := map[string]struct {
+		points           []point.Point
+		expected         LineSegment
+		expectedResidual float64
+	}{
+		"two points": {
+			points:   []point.Point{point.New(0, 0), point.New(3, 4)},
+			expected: New(0, 0, 3, 4),
+		},
+		"collinear points in any order": {
+			points:   []point.Point{point.New(2, 3), point.New(0, 1), point.New(3, 4), point.New(1, 2)},
+			expected: New(0, 1, 3, 4),
+		},
+		"vertical points": {
+			points:   []point.Point{point.New(5, 2), point.New(5, -1), point.New(5, 7)},
+			expected: New(5, -1, 5, 7),
+		},
+		"horizontal with symmetric noise": {
+			points: []point.Point{
+				point.New(0, 1), point.New(0, -1), point.New(2, 1), point.New(2, -1),
+				point.New(4, 1), point.New(4, -1), point.New(6, 1), point.New(6, -1),
+			},
+			expected:         New(0, 0, 6, 0),
+			expectedResidual: 1,
+		},
+		"vertical with symmetric noise": {
+			points: []point.Point{
+				point.New(-1, 0), point.New(1, 10), point.New(1, 0), point.New(-1, 10),
+				point.New(-1, 1), point.New(1, 9), point.New(1, 1), point.New(-1, 9),
+			},
+			expected:         New(0, 0, 0, 10),
+			expectedResidual: 1,
+		},
+		"steep line": {
+			points:   []point.Point{point.New(0.2, 2), point.New(0, 0), point.New(1, 10), point.New(0.1, 1)},
+			expected: New(0, 0, 1, 10),
+		},
+		"duplicates are allowed": {
+			points:   []point.Point{point.New(1, 1), point.New(1, 1), point.New(2, 1)},
+			expected: New(1, 1, 2, 1),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			actual, residual, err := FitToPoints(tc.points)
+			require.NoError(t, err)
+			assert.InDelta(t, tc.expected.Upper().X(), actual.Upper().X(), 1e-9, "expected %s, got %s", tc.expected, actual)
+			assert.InDelta(t, tc.expected.Upper().Y(), actual.Upper().Y(), 1e-9, "expected %s, got %s", tc.expected, actual)
+			assert.InDelta(t, tc.expected.Lower().X(), actual.Lower().X(), 1e-9, "expected %s, got %s", tc.expected, actual)
+			assert.InDelta(t, tc.expected.Lower().Y(), actual.Lower().Y(), 1e-9, "expected %s, got %s", tc.expected, actual)
+			assert.InDelta(t, tc.expectedResidual, residual, 1e-9)
+		})
+	}
+}
+
+func TestFitToPoints_Errors(t *testing.T) {
+	tests := map[string][]point.Point{
+		"no points":    nil,
+		"single point": {point.New(1, 1)},
+		"all the same": {point.New(1, 1), point.New(1, 1), point.New(1, 1)},
+	}
+
+	for name, points := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, _, err := FitToPoints(points)
+			assert.Error(t, err)
+		})
+	}
+}
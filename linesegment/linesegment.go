@@ -19,6 +19,8 @@
 //   - Intersection detection via FindIntersectionsFast:
 //     A more efficient method using the sweep line algorithm from
 //     [Computational Geometry: Algorithms and Applications], suitable for larger datasets.
+//   - Fitting: FitToPoints finds the best-fit line segment through a set of points, using total least squares.
+//   - Data interchange: ReadSegmentsCSV and WriteSegmentsCSV read and write lists of segments as CSV.
 //
 // # Line Segment Intersection Algorithms
 //
@@ -100,6 +102,53 @@ func NewFromPoints(p1, p2 point.Point) LineSegment {
 	}
 }
 
+// Angle calculates the angle of the LineSegment in radians, measured counterclockwise
+// from the positive X-axis.
+//
+// Returns:
+//   - float64: The angle of the line segment in the range [0, π), or math.NaN() if the
+//     line segment is degenerate (both endpoints are the same).
+//
+// Behavior:
+//   - A LineSegment has no direction (its endpoints are stored as upper and lower points), so the
+//     angle returned is that of the line through the segment. Horizontal segments return 0 and
+//     vertical segments return π/2.
+//   - The counterclockwise-positive convention matches [LineSegment.Rotate], so rotating a
+//     segment by θ increases its angle by θ (modulo π).
+func (l LineSegment) Angle() float64 {
+	dx := l.upper.X() - l.lower.X()
+	dy := l.upper.Y() - l.lower.Y()
+
+	if dx == 0 && dy == 0 {
+		return math.NaN() // Degenerate line segment, angle undefined
+	}
+
+	// upper is never below lower, so this is within [0, π]
+	angle := math.Atan2(dy, dx)
+	if angle >= math.Pi {
+		angle -= math.Pi
+	}
+	return angle
+}
+
+// AngleBetween calculates the unsigned angle in radians between this LineSegment and another.
+//
+// Parameters:
+//   - other (LineSegment): The line segment to measure the angle to.
+//
+// Returns:
+//   - float64: The acute angle between the two line segments in the range [0, π/2], or math.NaN()
+//     if either line segment is degenerate.
+//
+// Behavior:
+//   - The result is the smaller of the two angles formed where the lines through the segments meet,
+//     derived from the difference between the [LineSegment.Angle] of each segment.
+//   - Parallel and collinear segments return 0.
+func (l LineSegment) AngleBetween(other LineSegment) float64 {
+	d := math.Abs(l.Angle() - other.Angle())
+	return min(d, math.Pi-d)
+}
+
 // Bresenham generates all the integer points along the LineSegment using
 // Bresenham's line algorithm. It is an efficient way to rasterize a line
 // in a grid or pixel-based system.
@@ -197,7 +246,12 @@ func (l LineSegment) Center() point.Point {
 //   - This function uses the DistanceToPoint method to compute the distance.
 //   - Floating-point precision issues are handled using the global epsilon value.
 //   - The point must also be within the bounding box defined by the segment endpoints to return true.
+//   - A degenerate line segment (see [LineSegment.IsDegenerate]) is treated as a point, and contains p only if
+//     p is equal to one of its endpoints, as determined by [point.Point.Eq].
 func (l LineSegment) ContainsPoint(p point.Point) bool {
+	if l.IsDegenerate() {
+		return l.upper.Eq(p) || l.lower.Eq(p)
+	}
 
 	epsilon := geom2d.GetEpsilon()
 
@@ -317,6 +371,38 @@ func (l LineSegment) Eq(other LineSegment) bool {
 	return l.upper.Eq(other.upper) && l.lower.Eq(other.lower)
 }
 
+// Extend lengthens (or shortens) the LineSegment along its own direction, independently at each endpoint.
+//
+// Parameters:
+//   - byUpper (float64): The distance to move the upper point away from the lower point.
+//     Negative values move it towards the lower point, shortening the segment.
+//   - byLower (float64): The distance to move the lower point away from the upper point.
+//     Negative values move it towards the upper point, shortening the segment.
+//
+// Returns:
+//   - LineSegment: A new line segment with the adjusted endpoints.
+//
+// Behavior:
+//   - The endpoints are referred to as upper and lower, as a LineSegment has no start or end.
+//     See [LineSegment.Upper] and [LineSegment.Lower].
+//   - If the segment is shortened by more than its length, the endpoints pass each other and the
+//     upper and lower points of the result are swapped accordingly.
+//   - A degenerate line segment (with both endpoints the same) has no direction, and is returned unchanged.
+func (l LineSegment) Extend(byUpper, byLower float64) LineSegment {
+	if l.IsDegenerate() {
+		return l
+	}
+	length := l.Length()
+
+	// unit vector pointing from lower to upper
+	dir := l.upper.Sub(l.lower).Scale(point.Origin(), 1/length)
+
+	return NewFromPoints(
+		l.upper.Add(dir.Scale(point.Origin(), byUpper)),
+		l.lower.Sub(dir.Scale(point.Origin(), byLower)),
+	)
+}
+
 // IntersectionPoints calculates the points of intersection between two line segments.
 //
 // This function determines if and where two line segments intersect. It handles different cases:
@@ -338,8 +424,24 @@ func (l LineSegment) Eq(other LineSegment) bool {
 //   - For collinear segments, determines if they overlap and returns both endpoints of the
 //     overlapping section if they do.
 //   - Returns an empty slice and false if segments don't intersect.
+//   - A degenerate line segment (see [LineSegment.IsDegenerate]) is treated as a point: if it lies on the other
+//     segment, that point is returned as the single intersection point.
 func (l LineSegment) IntersectionPoints(other LineSegment) ([]point.Point, bool) {
 
+	// Degenerate segments are treated as points
+	if l.IsDegenerate() {
+		if other.ContainsPoint(l.upper) {
+			return []point.Point{l.upper}, true
+		}
+		return []point.Point{}, false
+	}
+	if other.IsDegenerate() {
+		if l.ContainsPoint(other.upper) {
+			return []point.Point{other.upper}, true
+		}
+		return []point.Point{}, false
+	}
+
 	// Line AB represented as a1x + b1y = c1
 	a1 := l.lower.Y() - l.upper.Y()
 	b1 := l.upper.X() - l.lower.X()
@@ -400,7 +502,17 @@ func (l LineSegment) IntersectionPoints(other LineSegment) ([]point.Point, bool)
 //   - The function first checks if the segments straddle each other (orientation test).
 //   - It also handles special cases like collinear segments that may overlap.
 //   - Two segments with coincident endpoints are considered to intersect.
+//   - A degenerate line segment (see [LineSegment.IsDegenerate]) is treated as a point, and intersects the other
+//     segment only if the other segment contains it.
 func (l LineSegment) Intersects(other LineSegment) bool {
+	// Degenerate segments are treated as points
+	if l.IsDegenerate() {
+		return other.ContainsPoint(l.upper)
+	}
+	if other.IsDegenerate() {
+		return l.ContainsPoint(other.upper)
+	}
+
 	a, b := l.upper, l.lower
 	c, d := other.upper, other.lower
 
@@ -415,23 +527,39 @@ func (l LineSegment) Intersects(other LineSegment) bool {
 		return true
 	}
 
-	// Special case: Check for collinear overlap
-	if o1 == 0 && NewFromPoints(a, c).ContainsPoint(b) {
+	// Special case: an endpoint of one segment is collinear with, and lies on, the other segment
+	if o1 == point.Collinear && l.ContainsPoint(c) {
 		return true
 	}
-	if o2 == 0 && NewFromPoints(a, d).ContainsPoint(b) {
+	if o2 == point.Collinear && l.ContainsPoint(d) {
 		return true
 	}
-	if o3 == 0 && NewFromPoints(c, a).ContainsPoint(d) {
+	if o3 == point.Collinear && other.ContainsPoint(a) {
 		return true
 	}
-	if o4 == 0 && NewFromPoints(c, b).ContainsPoint(d) {
+	if o4 == point.Collinear && other.ContainsPoint(b) {
 		return true
 	}
 
 	return false
 }
 
+// IsDegenerate reports whether the LineSegment has zero length, with both endpoints the same.
+//
+// A degenerate line segment has no direction, so methods that depend on direction (such as [LineSegment.Angle])
+// return an undefined result for it. Relationship and intersection methods treat a degenerate line segment as
+// a point instead.
+//
+// Returns:
+//   - bool: true if the upper and lower points are equal, as determined by [point.Point.Eq], false otherwise.
+//
+// Notes:
+//   - This uses the global epsilon value, so very short line segments are also considered degenerate.
+//     [FindIntersections] and [FindIntersectionsBruteForce] skip degenerate segments in their input.
+func (l LineSegment) IsDegenerate() bool {
+	return l.upper.Eq(l.lower)
+}
+
 // Length calculates the Euclidean distance (length) between the start and end points of the line segment.
 //
 // Returns:
@@ -449,6 +577,21 @@ func (l LineSegment) Lower() point.Point {
 	return l.lower
 }
 
+// Map applies a coordinate transformation function to both endpoints of the LineSegment.
+//
+// Parameters:
+//   - fn (func(point.Point) point.Point): The transformation to apply to each endpoint.
+//
+// Returns:
+//   - LineSegment: A new line segment between the transformed endpoints.
+//
+// Notes:
+//   - The upper and lower points of the result are determined afresh by [NewFromPoints], so they may be
+//     swapped relative to the original if fn reflects or rotates the segment.
+func (l LineSegment) Map(fn func(point.Point) point.Point) LineSegment {
+	return NewFromPoints(fn(l.upper), fn(l.lower))
+}
+
 // MarshalJSON serializes LineSegment as JSON while preserving its original type.
 func (l LineSegment) MarshalJSON() ([]byte, error) {
 	return json.Marshal(struct {
@@ -460,6 +603,120 @@ func (l LineSegment) MarshalJSON() ([]byte, error) {
 	})
 }
 
+// Normal returns the unit normal vector of the LineSegment.
+//
+// The normal is perpendicular to the line segment and points to the left of its direction from the
+// upper point to the lower point, consistent with [LineSegment.PerpendicularAt]. For example, the normal
+// of a horizontal segment points in the positive Y-direction, and the normal of a vertical segment points
+// in the positive X-direction.
+//
+// Returns:
+//   - point.Point: The unit normal vector.
+//   - error: An error if the line segment is degenerate, as it has no direction and so no defined normal.
+func (l LineSegment) Normal() (point.Point, error) {
+	if l.IsDegenerate() {
+		return point.Point{}, fmt.Errorf("degenerate line segment %s has no normal", l)
+	}
+	ab := l.lower.Sub(l.upper)
+	return point.New(-ab.Y(), ab.X()).Scale(point.Origin(), 1/l.Length()), nil
+}
+
+// OffsetParallel returns a copy of the LineSegment, shifted perpendicular to itself by a given distance.
+//
+// This is useful for drawing thick lines, or for generating the edges of a road from its centerline.
+//
+// Parameters:
+//   - distance (float64): The distance to shift the line segment. A positive distance shifts it in the
+//     direction of [LineSegment.Normal], and a negative distance shifts it in the opposite direction.
+//
+// Returns:
+//   - LineSegment: The shifted line segment, which is parallel to l and has the same length.
+//   - error: An error if the line segment is degenerate, as it has no defined normal.
+func (l LineSegment) OffsetParallel(distance float64) (LineSegment, error) {
+	normal, err := l.Normal()
+	if err != nil {
+		return LineSegment{}, err
+	}
+	return l.Translate(normal.Scale(point.Origin(), distance)), nil
+}
+
+// OverlapLength calculates the length of the collinear overlap between this LineSegment and another.
+//
+// This is useful for adjacency detection, such as finding walls shared between neighboring shapes.
+//
+// Parameters:
+//   - other (LineSegment): The line segment to compare with this segment.
+//
+// Returns:
+//   - float64: The length of the portion shared by both segments, or 0 if the segments are not collinear,
+//     do not overlap, or only touch at a single point.
+//
+// Behavior:
+//   - The segments are considered collinear if both endpoints of other are collinear with l,
+//     as determined by [point.Orientation]. This uses the global epsilon, so segments that are collinear
+//     to within floating-point error are treated as collinear.
+//   - If either segment is degenerate (with both endpoints the same), the result is 0.
+func (l LineSegment) OverlapLength(other LineSegment) float64 {
+	if l.IsDegenerate() || other.IsDegenerate() {
+		return 0
+	}
+	length := l.Length()
+
+	if point.Orientation(l.upper, l.lower, other.upper) != point.Collinear ||
+		point.Orientation(l.upper, l.lower, other.lower) != point.Collinear {
+		return 0
+	}
+
+	// project the endpoints of other onto l, as distances along l from its upper point
+	dir := l.lower.Sub(l.upper)
+	t1 := other.upper.Sub(l.upper).DotProduct(dir) / length
+	t2 := other.lower.Sub(l.upper).DotProduct(dir) / length
+
+	overlap := min(length, max(t1, t2)) - max(0, min(t1, t2))
+	return max(0, overlap)
+}
+
+// PerpendicularAt returns a LineSegment perpendicular to this one, centered at a point along it.
+//
+// Parameters:
+//   - t (float64): The position along the segment at which to place the perpendicular, where 0 is the
+//     upper point and 1 is the lower point. Values outside [0, 1] place it on the line beyond the segment.
+//   - length (float64): The total length of the returned segment.
+//
+// Returns:
+//   - LineSegment: A line segment of the given length, perpendicular to l and centered at the point at parameter t.
+//
+// Behavior:
+//   - A degenerate line segment (with both endpoints the same) has no direction, so a degenerate
+//     line segment at its endpoint is returned.
+func (l LineSegment) PerpendicularAt(t float64, length float64) LineSegment {
+	ab := l.lower.Sub(l.upper)
+	center := l.upper.Add(ab.Scale(point.Origin(), t))
+
+	if l.IsDegenerate() {
+		return NewFromPoints(center, center)
+	}
+	segmentLength := l.Length()
+
+	// half-length vector, perpendicular to ab
+	half := point.New(-ab.Y(), ab.X()).Scale(point.Origin(), length/(2*segmentLength))
+
+	return NewFromPoints(center.Add(half), center.Sub(half))
+}
+
+// PerpendicularBisector returns a LineSegment perpendicular to this one, centered at its midpoint.
+//
+// This is equivalent to calling [LineSegment.PerpendicularAt] with t = 0.5.
+//
+// Parameters:
+//   - length (float64): The total length of the returned segment.
+//
+// Returns:
+//   - LineSegment: A line segment of the given length, perpendicular to l and centered at [LineSegment.Center].
+func (l LineSegment) PerpendicularBisector(length float64) LineSegment {
+	return l.PerpendicularAt(0.5, length)
+}
+
 // Points returns the upper and lower points of the LineSegment.
 //
 // Returns:
@@ -581,11 +838,16 @@ func (l LineSegment) ReflectPoint(p point.Point) point.Point {
 //   - If the shortest distance between the point and the line segment is zero (or within the epsilon threshold),
 //     the function returns [types.RelationshipIntersection].
 //   - Otherwise, it returns [types.RelationshipDisjoint].
+//   - A degenerate line segment (see [LineSegment.IsDegenerate]) is treated as a point, and the result
+//     is that of [point.Point.RelationshipToPoint]: [types.RelationshipEqual] if p coincides with it.
 //
 // Notes:
 //   - This method is useful for determining if a point lies on a line segment, including endpoints and interior points.
 //   - Epsilon adjustment is particularly useful for floating-point coordinates to avoid precision errors.
 func (l LineSegment) RelationshipToPoint(p point.Point) types.Relationship {
+	if l.IsDegenerate() {
+		return l.upper.RelationshipToPoint(p)
+	}
 	distancePointToLineSegment := l.DistanceToPoint(p)
 	if distancePointToLineSegment == 0 {
 		return types.RelationshipIntersection
@@ -612,6 +874,27 @@ func (l LineSegment) Rotate(pivot point.Point, radians float64) LineSegment {
 	return NewFromPoints(newStart, newEnd)
 }
 
+// RotateOrthogonal rotates the LineSegment counter-clockwise around a given pivot [point.Point]
+// by a whole number of quarter turns (90°).
+//
+// Parameters:
+//   - pivot (point.Point): The point around which to rotate the line segment.
+//   - quarterTurns (int): The number of 90° counter-clockwise turns. Negative values rotate
+//     clockwise, and values outside [0, 3] are normalized modulo 4.
+//
+// Behavior:
+//   - Both endpoints are rotated using [point.Point.RotateOrthogonal], so the result is exact and
+//     integer coordinates remain integers.
+//
+// Returns:
+//   - LineSegment: A new line segment representing the rotated position.
+func (l LineSegment) RotateOrthogonal(pivot point.Point, quarterTurns int) LineSegment {
+	return NewFromPoints(
+		l.upper.RotateOrthogonal(pivot, quarterTurns),
+		l.lower.RotateOrthogonal(pivot, quarterTurns),
+	)
+}
+
 // Scale scales the line segment by a given factor from a specified reference point.
 //
 // Parameters:
@@ -662,6 +945,19 @@ func (l LineSegment) Slope() float64 {
 	return dy / dx
 }
 
+// StringWithPrecision returns a formatted string representation of the line segment in the same
+// format as [LineSegment.String], with each coordinate printed using the given number of decimal places.
+//
+// Parameters:
+//   - decimals (int): The number of digits after the decimal point. If negative, the shortest
+//     representation that exactly represents each coordinate is used.
+//
+// Returns:
+//   - string: A string representing the line segment's upper and lower coordinates, such as "(1.0,2.0)(3.0,0.5)".
+func (l LineSegment) StringWithPrecision(decimals int) string {
+	return l.upper.StringWithPrecision(decimals) + l.lower.StringWithPrecision(decimals)
+}
+
 // String returns a formatted string representation of the line segment for debugging and logging purposes.
 //
 // The string representation includes the coordinates of the start and end points in the format:
@@ -674,6 +970,84 @@ func (l LineSegment) String() string {
 	return fmt.Sprintf("(%v,%v)(%v,%v)", l.upper.X(), l.upper.Y(), l.lower.X(), l.lower.Y())
 }
 
+// Supercover generates every grid cell that the LineSegment passes through, using the supercover
+// variant of line rasterization. Unlike [LineSegment.Bresenham], it works with any floating-point coordinates,
+// and includes every cell touched by the segment rather than a thin approximation of it.
+//
+// The function is designed to be used with a for-loop, and thus takes a callback yield that processes each cell.
+// If the callback returns false at any point (if the calling for-loop is terminated, for example), the function
+// halts further generation.
+//
+// Example use cases include:
+//   - Exact grid rasterization.
+//   - Finding every grid cell a moving object crosses, for collision detection.
+//
+// Parameters:
+//   - yield (func([point.Point]) bool): A function that processes each cell, given as the integer coordinates
+//     of the cell's center. Returning false will stop further cell generation.
+//
+// Behavior:
+//   - Cells are unit squares centered on integer coordinates, matching the pixels produced by [LineSegment.Bresenham].
+//     The cell (i, j) covers the area from i-0.5 to i+0.5 horizontally and j-0.5 to j+0.5 vertically.
+//   - Cells are generated in order from the upper point to the lower point, using the grid traversal
+//     algorithm of Amanatides and Woo.
+//   - Where the segment passes exactly through the corner shared by four cells, both cells either side of
+//     the corner are generated as well, so diagonally adjacent cells are always connected.
+//   - A degenerate line segment (with both endpoints the same) generates the single cell containing it.
+func (l LineSegment) Supercover(yield func(point.Point) bool) {
+	x0, y0 := l.upper.X(), l.upper.Y()
+	dx := l.lower.X() - x0
+	dy := l.lower.Y() - y0
+
+	// cell containing the upper point
+	cx := math.Floor(x0 + 0.5)
+	cy := math.Floor(y0 + 0.5)
+
+	// for each axis, find the direction of travel, the parametric distance (t) to the first cell
+	// boundary crossed, and the parametric distance between successive cell boundaries
+	axis := func(start, delta, cell float64) (step, tMax, tDelta float64) {
+		switch {
+		case delta > 0:
+			return 1, (cell + 0.5 - start) / delta, 1 / delta
+		case delta < 0:
+			return -1, (cell - 0.5 - start) / delta, -1 / delta
+		default:
+			return 0, math.Inf(1), math.Inf(1)
+		}
+	}
+	stepX, tMaxX, tDeltaX := axis(x0, dx, cx)
+	stepY, tMaxY, tDeltaY := axis(y0, dy, cy)
+
+	for {
+		if !yield(point.New(cx, cy)) {
+			return
+		}
+
+		// stop once the next boundary is beyond the lower point
+		if min(tMaxX, tMaxY) > 1 {
+			return
+		}
+
+		switch {
+		case tMaxX < tMaxY:
+			cx += stepX
+			tMaxX += tDeltaX
+		case tMaxY < tMaxX:
+			cy += stepY
+			tMaxY += tDeltaY
+		default:
+			// passing exactly through a corner, include the cells either side of it
+			if !yield(point.New(cx+stepX, cy)) || !yield(point.New(cx, cy+stepY)) {
+				return
+			}
+			cx += stepX
+			cy += stepY
+			tMaxX += tDeltaX
+			tMaxY += tDeltaY
+		}
+	}
+}
+
 // Translate moves the LineSegment by a specified vector.
 //
 // This method shifts the LineSegment's position in the 2D plane by translating
@@ -718,6 +1092,26 @@ func (l LineSegment) Upper() point.Point {
 	return l.upper
 }
 
+// WithinDistance reports whether a point lies within a given distance of the LineSegment.
+//
+// This is equivalent to comparing [LineSegment.DistanceToPoint] against d, but compares squared distances
+// to avoid computing a square root.
+//
+// Parameters:
+//   - p (point.Point): The point to test.
+//   - d (float64): The maximum distance from the line segment.
+//
+// Returns:
+//   - bool: true if the shortest distance from p to the line segment is less than or equal to d, false otherwise.
+//
+// Behavior:
+//   - The boundary is included: a point exactly d away from the line segment is within the distance.
+//   - The comparison is exact, without epsilon tolerance.
+//   - If d is negative, false is returned.
+func (l LineSegment) WithinDistance(p point.Point, d float64) bool {
+	return l.ProjectPoint(p).WithinDistance(p, d)
+}
+
 // XAtY calculates the x-coordinate on the line segment at a given y-coordinate.
 //
 // Parameters:
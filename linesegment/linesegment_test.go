@@ -4,12 +4,122 @@ import (
 	"encoding/json"
 	"github.com/mikenye/geom2d"
 	"github.com/mikenye/geom2d/point"
+	"github.com/mikenye/geom2d/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"math"
 	"testing"
 )
 
+func TestLineSegment_Angle(t *testing.T) {
+	tests := map[string]struct {
+		lineSegment     LineSegment
+		expected        float64
+		shouldReturnNaN bool
+	}{
+		"horizontal": {
+			lineSegment: New(0, 0, 5, 0),
+			expected:    0,
+		},
+		"horizontal, reversed": {
+			lineSegment: New(5, 0, 0, 0),
+			expected:    0,
+		},
+		"vertical": {
+			lineSegment: New(0, 0, 0, 5),
+			expected:    math.Pi / 2,
+		},
+		"45 degrees": {
+			lineSegment: New(0, 0, 5, 5),
+			expected:    math.Pi / 4,
+		},
+		"135 degrees": {
+			lineSegment: New(0, 0, -5, 5),
+			expected:    3 * math.Pi / 4,
+		},
+		"135 degrees, reversed": {
+			lineSegment: New(-5, 5, 0, 0),
+			expected:    3 * math.Pi / 4,
+		},
+		"degenerate": {
+			lineSegment:     New(1, 1, 1, 1),
+			shouldReturnNaN: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			actual := tc.lineSegment.Angle()
+			if tc.shouldReturnNaN {
+				assert.True(t, math.IsNaN(actual), "expected NaN but got %v", actual)
+			} else {
+				assert.InDelta(t, tc.expected, actual, geom2d.GetEpsilon())
+			}
+		})
+	}
+}
+
+func TestLineSegment_AngleBetween(t *testing.T) {
+	tests := map[string]struct {
+		a, b            LineSegment
+		expected        float64
+		shouldReturnNaN bool
+	}{
+		"parallel": {
+			a:        New(0, 0, 5, 0),
+			b:        New(0, 2, 5, 2),
+			expected: 0,
+		},
+		"perpendicular": {
+			a:        New(0, 0, 5, 0),
+			b:        New(0, 0, 0, 5),
+			expected: math.Pi / 2,
+		},
+		"45 degrees": {
+			a:        New(0, 0, 5, 0),
+			b:        New(0, 0, 5, 5),
+			expected: math.Pi / 4,
+		},
+		"135 degrees is reported as the acute angle": {
+			a:        New(0, 0, 5, 0),
+			b:        New(0, 0, -5, 5),
+			expected: math.Pi / 4,
+		},
+		"near horizontal, below the x-axis": {
+			a:        New(0, 0, 10, 0),
+			b:        New(0, 0, 10, -0.1),
+			expected: math.Atan(0.01),
+		},
+		"near horizontal, above the x-axis": {
+			a:        New(0, 0, 10, 0),
+			b:        New(0, 0, 10, 0.1),
+			expected: math.Atan(0.01),
+		},
+		"near horizontal, either side of the x-axis": {
+			a:        New(0, 0, 10, 0.1),
+			b:        New(0, 0, 10, -0.1),
+			expected: 2 * math.Atan(0.01),
+		},
+		"degenerate": {
+			a:               New(0, 0, 5, 0),
+			b:               New(1, 1, 1, 1),
+			shouldReturnNaN: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			actual := tc.a.AngleBetween(tc.b)
+			if tc.shouldReturnNaN {
+				assert.True(t, math.IsNaN(actual), "expected NaN but got %v", actual)
+			} else {
+				assert.InDelta(t, tc.expected, actual, geom2d.GetEpsilon())
+				assert.InDelta(t, actual, tc.b.AngleBetween(tc.a), geom2d.GetEpsilon(), "expected symmetry")
+			}
+		})
+	}
+}
+
 func TestLineSegment_Bresenham(t *testing.T) {
 	tests := map[string]struct {
 		lineSegment LineSegment
@@ -230,6 +340,54 @@ func TestLineSegment_DistanceToLineSegment(t *testing.T) {
 			expected:   1.4142135623731,
 			expectZero: false,
 		},
+		"degenerate segment away from segment": {
+			segA:       New(5, 5, 5, 5),
+			segB:       New(0, 0, 1, 0),
+			expected:   math.Hypot(4, 5),
+			expectZero: false,
+		},
+		"degenerate segment on segment": {
+			segA:       New(0, 0, 4, 0),
+			segB:       New(2, 0, 2, 0),
+			expected:   0,
+			expectZero: true,
+		},
+		"two degenerate segments": {
+			segA:       New(0, 0, 0, 0),
+			segB:       New(3, 4, 3, 4),
+			expected:   5,
+			expectZero: false,
+		},
+		"collinear disjoint segments": {
+			segA:       New(0, 0, 1, 0),
+			segB:       New(5, 0, 9, 0),
+			expected:   4,
+			expectZero: false,
+		},
+		"endpoint collinear with the other segment but beyond it": {
+			segA:       New(0, 1, 2, 1),
+			segB:       New(3, 1, 4, 2),
+			expected:   1,
+			expectZero: false,
+		},
+		"collinear segments touching at an endpoint": {
+			segA:       New(0, 0, 2, 0),
+			segB:       New(2, 0, 5, 0),
+			expected:   0,
+			expectZero: true,
+		},
+		"collinear overlapping segments": {
+			segA:       New(0, 0, 3, 3),
+			segB:       New(2, 2, 5, 5),
+			expected:   0,
+			expectZero: true,
+		},
+		"endpoint touching the interior of the other segment": {
+			segA:       New(0, 0, 4, 0),
+			segB:       New(2, 0, 3, 5),
+			expected:   0,
+			expectZero: true,
+		},
 	}
 
 	for name, tt := range tests {
@@ -311,6 +469,229 @@ func TestLineSegment_Eq(t *testing.T) {
 	}
 }
 
+func TestLineSegment_Extend(t *testing.T) {
+	tests := map[string]struct {
+		seg              LineSegment
+		byUpper, byLower float64
+		expected         LineSegment
+	}{
+		"extend both ends of vertical segment": {
+			seg:      New(0, 0, 0, 10),
+			byUpper:  2,
+			byLower:  3,
+			expected: New(0, 12, 0, -3),
+		},
+		"extend upper only on diagonal segment": {
+			seg:      New(0, 0, 3, 4),
+			byUpper:  5,
+			byLower:  0,
+			expected: New(0, 0, 6, 8),
+		},
+		"shorten both ends": {
+			seg:      New(0, 0, 10, 0),
+			byUpper:  -2,
+			byLower:  -3,
+			expected: New(2, 0, 7, 0),
+		},
+		"shorten past the other endpoint": {
+			seg:      New(0, 0, 0, 10),
+			byUpper:  -15,
+			byLower:  0,
+			expected: New(0, 0, 0, -5),
+		},
+		"degenerate segment is unchanged": {
+			seg:      New(1, 1, 1, 1),
+			byUpper:  5,
+			byLower:  5,
+			expected: New(1, 1, 1, 1),
+		},
+		"segment shorter than epsilon is unchanged": {
+			seg:      New(1, 1, 1+1e-13, 1),
+			byUpper:  5,
+			byLower:  5,
+			expected: New(1, 1, 1+1e-13, 1),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			actual := tc.seg.Extend(tc.byUpper, tc.byLower)
+			assert.True(t, tc.expected.Eq(actual), "expected %s, got %s", tc.expected, actual)
+		})
+	}
+}
+
+func TestLineSegment_IntersectionPoints(t *testing.T) {
+	tests := map[string]struct {
+		segA, segB     LineSegment
+		expectedPoints []point.Point
+		expectedFound  bool
+	}{
+		"crossing segments": {
+			segA:           New(0, 0, 4, 4),
+			segB:           New(0, 4, 4, 0),
+			expectedPoints: []point.Point{point.New(2, 2)},
+			expectedFound:  true,
+		},
+		"disjoint segments": {
+			segA:           New(0, 0, 1, 0),
+			segB:           New(0, 2, 1, 2),
+			expectedPoints: []point.Point{},
+			expectedFound:  false,
+		},
+		"degenerate segment on segment": {
+			segA:           New(0.5, 0, 0.5, 0),
+			segB:           New(0, 0, 1, 0),
+			expectedPoints: []point.Point{point.New(0.5, 0)},
+			expectedFound:  true,
+		},
+		"segment containing degenerate segment": {
+			segA:           New(0, 0, 1, 1),
+			segB:           New(0.5, 0.5, 0.5, 0.5),
+			expectedPoints: []point.Point{point.New(0.5, 0.5)},
+			expectedFound:  true,
+		},
+		"degenerate segment away from segment": {
+			segA:           New(5, 5, 5, 5),
+			segB:           New(0, 0, 1, 0),
+			expectedPoints: []point.Point{},
+			expectedFound:  false,
+		},
+		"equal degenerate segments": {
+			segA:           New(2, 3, 2, 3),
+			segB:           New(2, 3, 2, 3),
+			expectedPoints: []point.Point{point.New(2, 3)},
+			expectedFound:  true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			actualPoints, actualFound := tc.segA.IntersectionPoints(tc.segB)
+			assert.Equal(t, tc.expectedFound, actualFound)
+			require.Len(t, actualPoints, len(tc.expectedPoints))
+			for i := range tc.expectedPoints {
+				assert.True(t, tc.expectedPoints[i].Eq(actualPoints[i]), "expected %s, got %s", tc.expectedPoints[i], actualPoints[i])
+			}
+		})
+	}
+}
+
+func TestLineSegment_Intersects(t *testing.T) {
+	tests := map[string]struct {
+		segA, segB LineSegment
+		expected   bool
+	}{
+		"crossing segments": {
+			segA:     New(0, 0, 4, 4),
+			segB:     New(0, 4, 4, 0),
+			expected: true,
+		},
+		"touching at an endpoint": {
+			segA:     New(0, 0, 4, 0),
+			segB:     New(4, 0, 4, 4),
+			expected: true,
+		},
+		"disjoint segments": {
+			segA:     New(0, 0, 1, 0),
+			segB:     New(0, 2, 1, 2),
+			expected: false,
+		},
+		"degenerate segment away from segment": {
+			segA:     New(5, 5, 5, 5),
+			segB:     New(0, 0, 1, 0),
+			expected: false,
+		},
+		"segment and degenerate segment away from it": {
+			segA:     New(0, 0, 1, 0),
+			segB:     New(5, 5, 5, 5),
+			expected: false,
+		},
+		"degenerate segment on segment": {
+			segA:     New(0.5, 0, 0.5, 0),
+			segB:     New(0, 0, 1, 0),
+			expected: true,
+		},
+		"degenerate segment at endpoint of segment": {
+			segA:     New(0, 0, 1, 1),
+			segB:     New(1, 1, 1, 1),
+			expected: true,
+		},
+		"different degenerate segments": {
+			segA:     New(0, 0, 0, 0),
+			segB:     New(1, 1, 1, 1),
+			expected: false,
+		},
+		"collinear disjoint segments": {
+			segA:     New(0, 0, 1, 0),
+			segB:     New(5, 0, 9, 0),
+			expected: false,
+		},
+		"collinear disjoint segments, reversed": {
+			segA:     New(5, 0, 9, 0),
+			segB:     New(0, 0, 1, 0),
+			expected: false,
+		},
+		"endpoint collinear with the other segment but beyond it": {
+			segA:     New(0, 1, 2, 1),
+			segB:     New(3, 1, 4, 2),
+			expected: false,
+		},
+		"collinear segments touching at an endpoint": {
+			segA:     New(0, 0, 2, 0),
+			segB:     New(2, 0, 5, 0),
+			expected: true,
+		},
+		"collinear overlapping segments": {
+			segA:     New(0, 0, 3, 3),
+			segB:     New(2, 2, 5, 5),
+			expected: true,
+		},
+		"collinear segment inside the other": {
+			segA:     New(0, 0, 10, 0),
+			segB:     New(3, 0, 4, 0),
+			expected: true,
+		},
+		"endpoint touching the interior of the other segment": {
+			segA:     New(0, 0, 4, 0),
+			segB:     New(2, 0, 3, 5),
+			expected: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.segA.Intersects(tc.segB))
+		})
+	}
+}
+
+func TestLineSegment_IsDegenerate(t *testing.T) {
+	tests := map[string]struct {
+		segment  LineSegment
+		expected bool
+	}{
+		"zero length": {
+			segment:  New(3, 4, 3, 4),
+			expected: true,
+		},
+		"shorter than epsilon": {
+			segment:  New(3, 4, 3+1e-13, 4),
+			expected: true,
+		},
+		"non-zero length": {
+			segment:  New(3, 4, 3, 5),
+			expected: false,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.segment.IsDegenerate())
+		})
+	}
+}
+
 func TestLineSegment_Length(t *testing.T) {
 	tests := map[string]struct {
 		lineSegment LineSegment
@@ -342,6 +723,31 @@ func TestLineSegment_Length(t *testing.T) {
 	}
 }
 
+func TestLineSegment_Map(t *testing.T) {
+	tests := map[string]struct {
+		seg      LineSegment
+		fn       func(point.Point) point.Point
+		expected LineSegment
+	}{
+		"translate": {
+			seg:      New(0, 0, 2, 3),
+			fn:       func(p point.Point) point.Point { return p.Translate(point.New(1, 1)) },
+			expected: New(1, 1, 3, 4),
+		},
+		"reflect across X-axis swaps upper and lower": {
+			seg:      New(0, 0, 2, 3),
+			fn:       func(p point.Point) point.Point { return point.New(p.X(), -p.Y()) },
+			expected: New(0, 0, 2, -3),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.seg.Map(tc.fn))
+		})
+	}
+}
+
 func TestLineSegment_MarshalUnmarshalJSON(t *testing.T) {
 	tests := map[string]struct {
 		segment  LineSegment // Input segment
@@ -368,6 +774,211 @@ func TestLineSegment_MarshalUnmarshalJSON(t *testing.T) {
 	}
 }
 
+func TestLineSegment_Normal(t *testing.T) {
+	tests := map[string]struct {
+		segment     LineSegment
+		expected    point.Point
+		expectedErr bool
+	}{
+		"horizontal":          {segment: New(0, 0, 4, 0), expected: point.New(0, 1)},
+		"horizontal reversed": {segment: New(4, 0, 0, 0), expected: point.New(0, 1)},
+		"vertical":            {segment: New(1, 0, 1, 5), expected: point.New(1, 0)},
+		"diagonal":            {segment: New(0, 0, 3, 3), expected: point.New(math.Sqrt2/2, -math.Sqrt2/2)},
+		"degenerate":          {segment: New(1, 1, 1, 1), expectedErr: true},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			actual, err := tc.segment.Normal()
+			if tc.expectedErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, tc.expected.Eq(actual), "expected %s, got %s", tc.expected, actual)
+		})
+	}
+}
+
+func TestLineSegment_OffsetParallel(t *testing.T) {
+	tests := map[string]struct {
+		segment     LineSegment
+		distance    float64
+		expected    LineSegment
+		expectedErr bool
+	}{
+		"horizontal, positive":   {segment: New(0, 0, 4, 0), distance: 2, expected: New(0, 2, 4, 2)},
+		"horizontal, negative":   {segment: New(0, 0, 4, 0), distance: -2, expected: New(0, -2, 4, -2)},
+		"vertical, positive":     {segment: New(1, 0, 1, 5), distance: 1.5, expected: New(2.5, 0, 2.5, 5)},
+		"diagonal, positive":     {segment: New(0, 0, 3, 4), distance: 5, expected: New(4, -3, 7, 1)},
+		"zero distance":          {segment: New(0, 0, 3, 4), distance: 0, expected: New(0, 0, 3, 4)},
+		"degenerate is an error": {segment: New(1, 1, 1, 1), distance: 1, expectedErr: true},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			actual, err := tc.segment.OffsetParallel(tc.distance)
+			if tc.expectedErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, tc.expected.Eq(actual), "expected %s, got %s", tc.expected, actual)
+			assert.InDelta(t, tc.segment.Length(), actual.Length(), geom2d.GetEpsilon())
+		})
+	}
+}
+
+func TestLineSegment_OverlapLength(t *testing.T) {
+	tests := map[string]struct {
+		segA, segB LineSegment
+		expected   float64
+	}{
+		"partial overlap": {
+			segA:     New(0, 0, 10, 0),
+			segB:     New(6, 0, 14, 0),
+			expected: 4,
+		},
+		"fully contained": {
+			segA:     New(0, 0, 10, 10),
+			segB:     New(2, 2, 5, 5),
+			expected: 3 * math.Sqrt2,
+		},
+		"fully containing": {
+			segA:     New(2, 2, 5, 5),
+			segB:     New(0, 0, 10, 10),
+			expected: 3 * math.Sqrt2,
+		},
+		"identical": {
+			segA:     New(0, 0, 0, 7),
+			segB:     New(0, 7, 0, 0),
+			expected: 7,
+		},
+		"touching only": {
+			segA:     New(0, 0, 5, 0),
+			segB:     New(5, 0, 9, 0),
+			expected: 0,
+		},
+		"collinear but disjoint": {
+			segA:     New(0, 0, 5, 0),
+			segB:     New(6, 0, 9, 0),
+			expected: 0,
+		},
+		"parallel but not collinear": {
+			segA:     New(0, 0, 5, 0),
+			segB:     New(0, 1, 5, 1),
+			expected: 0,
+		},
+		"intersecting": {
+			segA:     New(0, 0, 4, 4),
+			segB:     New(0, 4, 4, 0),
+			expected: 0,
+		},
+		"nearly collinear within epsilon": {
+			segA:     New(0, 0, 10, 0),
+			segB:     New(5, 1e-13, 15, -1e-13),
+			expected: 5,
+		},
+		"degenerate": {
+			segA:     New(0, 0, 10, 0),
+			segB:     New(5, 0, 5, 0),
+			expected: 0,
+		},
+		"shorter than epsilon": {
+			segA:     New(5, 0, 5+1e-13, 0),
+			segB:     New(0, 0, 10, 0),
+			expected: 0,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.InDelta(t, tc.expected, tc.segA.OverlapLength(tc.segB), geom2d.GetEpsilon())
+		})
+	}
+}
+
+func TestLineSegment_PerpendicularAt(t *testing.T) {
+	tests := map[string]struct {
+		seg      LineSegment
+		t        float64
+		length   float64
+		expected LineSegment
+	}{
+		"at upper point of vertical segment": {
+			seg:      New(0, 0, 0, 10),
+			t:        0,
+			length:   4,
+			expected: New(-2, 10, 2, 10),
+		},
+		"at lower point of vertical segment": {
+			seg:      New(0, 0, 0, 10),
+			t:        1,
+			length:   4,
+			expected: New(-2, 0, 2, 0),
+		},
+		"quarter way along horizontal segment": {
+			seg:      New(0, 0, 8, 0),
+			t:        0.25,
+			length:   2,
+			expected: New(2, -1, 2, 1),
+		},
+		"beyond the segment": {
+			seg:      New(0, 0, 8, 0),
+			t:        1.5,
+			length:   2,
+			expected: New(12, -1, 12, 1),
+		},
+		"degenerate segment": {
+			seg:      New(3, 3, 3, 3),
+			t:        0.5,
+			length:   2,
+			expected: New(3, 3, 3, 3),
+		},
+		"segment shorter than epsilon": {
+			seg:      New(3, 3, 3+1e-13, 3),
+			t:        0.5,
+			length:   2,
+			expected: New(3, 3, 3, 3),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			actual := tc.seg.PerpendicularAt(tc.t, tc.length)
+			assert.True(t, tc.expected.Eq(actual), "expected %s, got %s", tc.expected, actual)
+		})
+	}
+}
+
+func TestLineSegment_PerpendicularBisector(t *testing.T) {
+	tests := map[string]struct {
+		seg      LineSegment
+		length   float64
+		expected LineSegment
+	}{
+		"horizontal segment": {
+			seg:      New(0, 0, 4, 0),
+			length:   6,
+			expected: New(2, -3, 2, 3),
+		},
+		"diagonal segment": {
+			seg:      New(0, 0, 2, 2),
+			length:   2 * math.Sqrt2,
+			expected: New(0, 2, 2, 0),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			actual := tc.seg.PerpendicularBisector(tc.length)
+			assert.True(t, tc.expected.Eq(actual), "expected %s, got %s", tc.expected, actual)
+			assert.InDelta(t, tc.length, actual.Length(), geom2d.GetEpsilon())
+			assert.InDelta(t, 0, tc.seg.Upper().Sub(tc.seg.Lower()).DotProduct(actual.Upper().Sub(actual.Lower())), geom2d.GetEpsilon())
+		})
+	}
+}
+
 func TestLineSegment_Points(t *testing.T) {
 	tests := map[string]struct {
 		segment  LineSegment
@@ -519,6 +1130,56 @@ func TestLineSegment_ReflectPoint(t *testing.T) {
 	}
 }
 
+func TestLineSegment_RelationshipToPoint(t *testing.T) {
+	tests := map[string]struct {
+		seg      LineSegment
+		p        point.Point
+		expected types.Relationship
+	}{
+		"interior point": {
+			seg:      New(0, 0, 10, 10),
+			p:        point.New(4, 4),
+			expected: types.RelationshipIntersection,
+		},
+		"endpoint": {
+			seg:      New(0, 0, 10, 10),
+			p:        point.New(10, 10),
+			expected: types.RelationshipIntersection,
+		},
+		"off the segment": {
+			seg:      New(0, 0, 10, 10),
+			p:        point.New(4, 5),
+			expected: types.RelationshipDisjoint,
+		},
+		"beyond the segment on its line": {
+			seg:      New(0, 0, 10, 10),
+			p:        point.New(11, 11),
+			expected: types.RelationshipDisjoint,
+		},
+		"degenerate segment at the point": {
+			seg:      New(2, 3, 2, 3),
+			p:        point.New(2, 3),
+			expected: types.RelationshipEqual,
+		},
+		"degenerate segment elsewhere": {
+			seg:      New(2, 3, 2, 3),
+			p:        point.New(3, 3),
+			expected: types.RelationshipDisjoint,
+		},
+		"segment shorter than epsilon": {
+			seg:      New(0, 0, 1e-13, 0),
+			p:        point.New(5e-13, 0),
+			expected: types.RelationshipEqual,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.seg.RelationshipToPoint(tc.p))
+		})
+	}
+}
+
 func TestLineSegment_Rotate(t *testing.T) {
 	tests := map[string]struct {
 		seg      LineSegment
@@ -563,6 +1224,41 @@ func TestLineSegment_Rotate(t *testing.T) {
 	}
 }
 
+func TestLineSegment_RotateOrthogonal(t *testing.T) {
+	tests := map[string]struct {
+		seg          LineSegment
+		pivot        point.Point
+		quarterTurns int
+		expected     LineSegment
+	}{
+		"one turn around origin": {
+			seg:          New(1, 0, 3, 0),
+			pivot:        point.New(0, 0),
+			quarterTurns: 1,
+			expected:     New(0, 1, 0, 3),
+		},
+		"two turns around custom pivot": {
+			seg:          New(1, 0, 0, 1),
+			pivot:        point.New(1, 1),
+			quarterTurns: 2,
+			expected:     New(1, 2, 2, 1),
+		},
+		"negative turn is clockwise": {
+			seg:          New(1, 0, 3, 0),
+			pivot:        point.New(0, 0),
+			quarterTurns: -1,
+			expected:     New(0, -1, 0, -3),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			actual := tc.seg.RotateOrthogonal(tc.pivot, tc.quarterTurns)
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
 func TestLineSegment_Scale(t *testing.T) {
 	tests := map[string]struct {
 		segment  LineSegment
@@ -692,6 +1388,108 @@ func TestLineSegment_String(t *testing.T) {
 	}
 }
 
+func TestLineSegment_StringWithPrecision(t *testing.T) {
+	tests := map[string]struct {
+		segment  LineSegment
+		decimals int
+		expected string
+	}{
+		"one decimal": {
+			segment:  New(1.25, 1.5, 4.333, 5.5),
+			decimals: 1,
+			expected: "(4.3,5.5)(1.2,1.5)",
+		},
+		"zero decimals": {
+			segment:  New(0, 0, 2.6, 3),
+			decimals: 0,
+			expected: "(3,3)(0,0)",
+		},
+		"negative decimals uses shortest representation": {
+			segment:  New(1.5, 1.5, 4.5, 5.5),
+			decimals: -1,
+			expected: "(4.5,5.5)(1.5,1.5)",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			result := tt.segment.StringWithPrecision(tt.decimals)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestLineSegment_Supercover(t *testing.T) {
+	tests := map[string]struct {
+		lineSegment LineSegment
+		expected    []point.Point
+	}{
+		"horizontal line": {
+			lineSegment: New(0, 0, 3, 0),
+			expected: []point.Point{
+				point.New(0, 0), point.New(1, 0), point.New(2, 0), point.New(3, 0),
+			},
+		},
+		"vertical line, fractional endpoints": {
+			lineSegment: New(0.2, -0.4, 0.2, 2.3),
+			expected: []point.Point{
+				point.New(0, 2), point.New(0, 1), point.New(0, 0),
+			},
+		},
+		"diagonal line through corners": {
+			lineSegment: New(0, 0, 2, 2),
+			expected: []point.Point{
+				point.New(2, 2), point.New(1, 2), point.New(2, 1),
+				point.New(1, 1), point.New(0, 1), point.New(1, 0),
+				point.New(0, 0),
+			},
+		},
+		"shallow line includes cells skipped by Bresenham": {
+			lineSegment: New(0, 0, 4, 1),
+			expected: []point.Point{
+				point.New(4, 1), point.New(3, 1), point.New(2, 1),
+				point.New(2, 0), point.New(1, 0), point.New(0, 0),
+			},
+		},
+		"degenerate line segment": {
+			lineSegment: New(1.3, 1.7, 1.3, 1.7),
+			expected:    []point.Point{point.New(1, 2)},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			var actual []point.Point
+			tc.lineSegment.Supercover(func(p point.Point) bool {
+				actual = append(actual, p)
+				return true
+			})
+			assert.Equal(t, tc.expected, actual, "Supercover cells mismatch")
+		})
+	}
+
+	t.Run("contains every Bresenham point", func(t *testing.T) {
+		l := New(-3, 2, 7, -4)
+		cells := make(map[point.Point]struct{})
+		l.Supercover(func(p point.Point) bool {
+			cells[p] = struct{}{}
+			return true
+		})
+		l.Bresenham(func(p point.Point) bool {
+			assert.Contains(t, cells, p)
+			return true
+		})
+	})
+
+	t.Run("early termination", func(t *testing.T) {
+		count := 0
+		New(0, 0, 10, 0).Supercover(func(p point.Point) bool {
+			count++
+			return count < 3
+		})
+		assert.Equal(t, 3, count)
+	})
+}
+
 func TestLineSegment_Translate(t *testing.T) {
 	tests := map[string]struct {
 		lineSegment LineSegment
@@ -733,6 +1531,30 @@ func TestLineSegment_Translate(t *testing.T) {
 	}
 }
 
+func TestLineSegment_WithinDistance(t *testing.T) {
+	tests := map[string]struct {
+		segment  LineSegment
+		p        point.Point
+		d        float64
+		expected bool
+	}{
+		"on the segment":               {segment: New(0, 0, 10, 0), p: point.New(5, 0), d: 0, expected: true},
+		"beside the segment, within":   {segment: New(0, 0, 10, 0), p: point.New(5, 2), d: 3, expected: true},
+		"beside the segment, exactly":  {segment: New(0, 0, 10, 0), p: point.New(5, 2), d: 2, expected: true},
+		"beside the segment, beyond":   {segment: New(0, 0, 10, 0), p: point.New(5, 2), d: 1.999, expected: false},
+		"beyond an endpoint, exactly":  {segment: New(0, 0, 10, 0), p: point.New(13, 4), d: 5, expected: true},
+		"beyond an endpoint, beyond":   {segment: New(0, 0, 10, 0), p: point.New(13, 4), d: 4.999, expected: false},
+		"degenerate segment":           {segment: New(1, 1, 1, 1), p: point.New(4, 5), d: 5, expected: true},
+		"negative distance on segment": {segment: New(0, 0, 10, 0), p: point.New(5, 0), d: -1, expected: false},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.segment.WithinDistance(tc.p, tc.d))
+		})
+	}
+}
+
 func TestLineSegment_XAtY(t *testing.T) {
 	tests := map[string]struct {
 		lineSegment LineSegment
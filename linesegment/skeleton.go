@@ -0,0 +1,344 @@
+package linesegment
+
+import (
+	"fmt"
+	"github.com/mikenye/geom2d"
+	"github.com/mikenye/geom2d/point"
+	"math"
+	"slices"
+)
+
+// StraightSkeleton computes the straight skeleton of a simple polygon.
+//
+// The straight skeleton is traced by the vertices of the polygon as its edges are moved inwards, parallel to
+// themselves and at the same speed, until the polygon vanishes. It is used for roof generation, label placement
+// and centerline extraction: unlike the medial axis, it is made up entirely of straight line segments.
+//
+// Parameters:
+//   - ring ([]point.Point): The vertices of the polygon, in order. The first point should not be repeated at the end.
+//     The slice is not modified.
+//
+// Returns:
+//   - []LineSegment: The arcs of the skeleton. Each vertex of the polygon is the endpoint of one arc, and the
+//     remaining arcs join the points at which the shrinking polygon changed shape.
+//   - error: An error if the ring is not a simple polygon, as determined by [point.IsSimplePolygon].
+//
+// Behavior:
+//   - The winding direction (clockwise or counterclockwise) of the ring does not matter.
+//   - Vertices collinear with their neighbors are removed first, using [point.RemoveCollinearPoints], as they do
+//     not change the shape of the polygon.
+//   - Where several changes of shape happen at the same point, such as at the center of a square, the arcs
+//     meet there; no zero-length arcs are returned. A polygon of n vertices with no such coincidences has
+//     2n-3 arcs.
+//   - The shrinking polygon is simulated event by event, recomputing the next event each time from every
+//     vertex and edge, so this takes O(n³) time in the worst case for a polygon of n vertices.
+//
+// Notes:
+//   - Polygons with holes are not supported, as a single ring is the only input: to find the skeleton of a
+//     polygon with holes, the holes would need to be part of the same simulation.
+//   - Coinciding events are detected using a tolerance of the global epsilon value, scaled by the size of
+//     the polygon.
+func StraightSkeleton(ring []point.Point) ([]LineSegment, error) {
+	vertices := point.RemoveCollinearPoints(ring)
+	if !point.IsSimplePolygon(vertices) {
+		return nil, fmt.Errorf("cannot compute straight skeleton: polygon is not simple")
+	}
+	if point.IsClockwise(vertices) {
+		slices.Reverse(vertices)
+	}
+
+	s := newSkeleton(vertices)
+	maxEvents := 4 * len(vertices) * len(vertices)
+	for range maxEvents {
+		s.resolveCoincidences()
+		if len(s.active) == 0 {
+			return s.arcs, nil
+		}
+		event, ok := s.nextEvent()
+		if !ok {
+			break
+		}
+		s.apply(event)
+	}
+	return nil, fmt.Errorf("cannot compute straight skeleton: the wavefront did not collapse")
+}
+
+// skeletonEdge is an edge of the polygon whose straight skeleton is being computed, moving inwards over time.
+type skeletonEdge struct {
+	origin    point.Point // a point on the edge at time 0
+	direction point.Point // unit vector along the edge, counterclockwise around the polygon
+	normal    point.Point // unit vector perpendicular to the edge, pointing into the polygon
+}
+
+// along returns the distance of p along the line of the edge, from its origin.
+func (e skeletonEdge) along(p point.Point) float64 {
+	return p.Sub(e.origin).DotProduct(e.direction)
+}
+
+// skeletonVertex is a vertex of the shrinking polygon (the wavefront), where two moving edges meet.
+type skeletonVertex struct {
+	origin      point.Point // position at the time the vertex was created
+	time        float64     // time (inward offset distance) at which the vertex was created
+	left, right int         // indices of the edges before and after the vertex, counterclockwise
+	velocity    point.Point
+	parallel    bool // the edges face each other, so the vertex has no defined velocity
+	removed     bool
+	prev, next  *skeletonVertex
+}
+
+// at returns the position of the vertex at time t.
+func (v *skeletonVertex) at(t float64) point.Point {
+	return v.origin.Add(v.velocity.Scale(point.Origin(), t-v.time))
+}
+
+// skeletonEvent is a change of shape of the wavefront. For an edge event, the edge after v shrinks to nothing.
+// For a split event, the reflex vertex v reaches the edge between u and u.next, splitting the wavefront in two.
+type skeletonEvent struct {
+	time  float64
+	point point.Point
+	v, u  *skeletonVertex // u is nil for an edge event
+}
+
+// skeleton holds the state of a straight skeleton computation.
+type skeleton struct {
+	edges     []skeletonEdge
+	active    []*skeletonVertex
+	arcs      []LineSegment
+	now       float64
+	tolerance float64
+}
+
+// newSkeleton starts a straight skeleton computation for the counterclockwise simple polygon ring.
+func newSkeleton(ring []point.Point) *skeleton {
+	n := len(ring)
+	minX, minY, maxX, maxY := math.Inf(1), math.Inf(1), math.Inf(-1), math.Inf(-1)
+	s := &skeleton{edges: make([]skeletonEdge, n)}
+	for i, a := range ring {
+		d := ring[(i+1)%n].Sub(a)
+		d = d.Scale(point.Origin(), 1/math.Hypot(d.X(), d.Y()))
+		s.edges[i] = skeletonEdge{origin: a, direction: d, normal: point.New(-d.Y(), d.X())}
+		minX, minY, maxX, maxY = min(minX, a.X()), min(minY, a.Y()), max(maxX, a.X()), max(maxY, a.Y())
+	}
+	s.tolerance = geom2d.GetEpsilon() * max(1, maxX-minX, maxY-minY)
+
+	for i, p := range ring {
+		s.active = append(s.active, s.newVertex(p, 0, (i+n-1)%n, i))
+	}
+	for i, v := range s.active {
+		v.prev, v.next = s.active[(i+n-1)%n], s.active[(i+1)%n]
+	}
+	return s
+}
+
+// newVertex creates a wavefront vertex at p, at time t, between the given edges.
+// The vertex moves so that it stays on both edges as they move inwards.
+func (s *skeleton) newVertex(p point.Point, t float64, left, right int) *skeletonVertex {
+	v := &skeletonVertex{origin: p, time: t, left: left, right: right}
+	n1, n2 := s.edges[left].normal, s.edges[right].normal
+	if denominator := 1 + n1.DotProduct(n2); denominator > 1e-9 {
+		v.velocity = n1.Add(n2).Scale(point.Origin(), 1/denominator)
+	} else {
+		v.parallel = true
+	}
+	return v
+}
+
+// near reports whether p and q coincide, within the tolerance of the computation.
+func (s *skeleton) near(p, q point.Point) bool {
+	return p.DistanceToPoint(q) <= s.tolerance
+}
+
+// addArc adds the arc from p to q to the skeleton, unless it has zero length.
+func (s *skeleton) addArc(p, q point.Point) {
+	if !s.near(p, q) {
+		s.arcs = append(s.arcs, NewFromPoints(p, q))
+	}
+}
+
+// retire removes v from the wavefront, adding the arc it traced to p to the skeleton.
+func (s *skeleton) retire(v *skeletonVertex, p point.Point) {
+	s.addArc(v.origin, p)
+	v.removed = true
+}
+
+// meet reports whether the wavefront vertices v and w are at the same point at the current time, and if so,
+// returns that point, as given by [skeleton.meetingPoint]. The faster a vertex moves, the less precisely its
+// position is known, so the tolerance grows with the speed of the vertices.
+func (s *skeleton) meet(v, w *skeletonVertex) (point.Point, bool) {
+	speedV := v.velocity.DistanceToPoint(point.Origin())
+	speedW := w.velocity.DistanceToPoint(point.Origin())
+	if v.at(s.now).DistanceToPoint(w.at(s.now)) > s.tolerance*(1+speedV+speedW) {
+		return point.Point{}, false
+	}
+	return s.meetingPoint(v, w), true
+}
+
+// meetingPoint returns the point at which the wavefront vertices v and w meet at the current time: the
+// position of whichever has moved the least, and so has the most precisely known position.
+func (s *skeleton) meetingPoint(v, w *skeletonVertex) point.Point {
+	distanceV := v.velocity.DistanceToPoint(point.Origin()) * (s.now - v.time)
+	distanceW := w.velocity.DistanceToPoint(point.Origin()) * (s.now - w.time)
+	if distanceW < distanceV {
+		return w.at(s.now)
+	}
+	return v.at(s.now)
+}
+
+// link joins the wavefront vertices a and b, with a before b.
+func link(a, b *skeletonVertex) {
+	a.next, b.prev = b, a
+}
+
+// replace removes the vertices from first to last inclusive from the wavefront, adding the arcs they traced to
+// the origin of v, and puts v in their place.
+func (s *skeleton) replace(first, last, v *skeletonVertex) {
+	before, after := first.prev, last.next
+	for u := first; ; u = u.next {
+		s.retire(u, v.origin)
+		if u == last {
+			break
+		}
+	}
+	link(before, v)
+	link(v, after)
+	s.active = append(s.active, v)
+}
+
+// resolveCoincidences tidies the wavefront at the current time: it finishes wavefronts that have collapsed to
+// a point or a line segment, merges vertices that have met, and removes the zero-width slivers left where two
+// edges facing each other have met.
+func (s *skeleton) resolveCoincidences() {
+	for changed := true; changed; {
+		changed = false
+		for _, v := range s.active {
+			if v.removed {
+				continue
+			}
+			p, met := s.meet(v, v.next)
+			changed = true
+			switch {
+			case v.next == v.prev && !v.parallel:
+				// two vertices left, on the same two edges, which meet at only one point: the wavefront has
+				// collapsed to that point
+				p = s.meetingPoint(v, v.next)
+				s.retire(v, p)
+				s.retire(v.next, p)
+			case v.next == v.prev:
+				// two vertices left, on two edges lying on the same line: the wavefront has collapsed onto the
+				// segment between them
+				w := v.next
+				s.retire(v, v.at(s.now))
+				s.retire(w, w.at(s.now))
+				s.addArc(v.at(s.now), w.at(s.now))
+			case met:
+				w := v.next
+				s.replace(v, w, s.newVertex(p, s.now, v.left, w.right))
+			case v.parallel:
+				// both edges of v lie on the same line: the nearer neighbor of v is where the sliver ends
+				prev, next := v.prev.at(s.now), v.next.at(s.now)
+				if v.origin.DistanceToPoint(prev) <= v.origin.DistanceToPoint(next) {
+					s.replace(v.prev, v, s.newVertex(prev, s.now, v.prev.left, v.right))
+				} else {
+					s.replace(v, v.next, s.newVertex(next, s.now, v.left, v.next.right))
+				}
+			default:
+				changed = false
+			}
+			if changed {
+				break
+			}
+		}
+		s.active = slices.DeleteFunc(s.active, func(v *skeletonVertex) bool { return v.removed })
+	}
+}
+
+// nextEvent finds the earliest change of shape of the wavefront. Edge events are preferred over split events
+// happening at the same time.
+func (s *skeleton) nextEvent() (skeletonEvent, bool) {
+	best, found := skeletonEvent{time: math.Inf(1)}, false
+	consider := func(e skeletonEvent, isEdgeEvent bool) {
+		if e.time < s.now-s.tolerance {
+			return
+		}
+		e.time = max(e.time, s.now)
+		if e.time < best.time-s.tolerance || (isEdgeEvent && best.u != nil && e.time <= best.time+s.tolerance) {
+			best, found = e, true
+		}
+	}
+
+	// wavefront vertices are in the same loop if they share a label
+	labels := make(map[*skeletonVertex]int, len(s.active))
+	for label, v := range s.active {
+		if _, ok := labels[v]; ok {
+			continue
+		}
+		for u := v; ; u = u.next {
+			labels[u] = label
+			if u.next == v {
+				break
+			}
+		}
+	}
+
+	for _, v := range s.active {
+		// edge event: the edge after v shrinks to nothing
+		w := v.next
+		edge := s.edges[v.right]
+		bv, bw := v.velocity.DotProduct(edge.direction), w.velocity.DotProduct(edge.direction)
+		if bv-bw > 1e-12 {
+			sv := edge.along(v.origin) - v.time*bv
+			sw := edge.along(w.origin) - w.time*bw
+			t := (sw - sv) / (bv - bw)
+			p, q := v.at(t), w.at(t)
+			consider(skeletonEvent{time: t, point: point.New((p.X()+q.X())/2, (p.Y()+q.Y())/2), v: v}, true)
+		}
+
+		// split events: a reflex vertex reaches an edge of the same loop that it is not part of
+		if v.parallel || s.edges[v.left].direction.CrossProduct(s.edges[v.right].direction) >= 0 {
+			continue
+		}
+		for _, u := range s.active {
+			if u == v || u.next == v || labels[u] != labels[v] || u.right == v.left || u.right == v.right {
+				continue
+			}
+			edge := s.edges[u.right]
+			vn := v.velocity.DotProduct(edge.normal)
+			if 1-vn <= 1e-12 {
+				continue
+			}
+			t := (v.origin.Sub(edge.origin).DotProduct(edge.normal) - v.time*vn) / (1 - vn)
+			p := v.at(t)
+			along := edge.along(p)
+			if along < edge.along(u.at(t))-s.tolerance || along > edge.along(u.next.at(t))+s.tolerance {
+				continue
+			}
+			consider(skeletonEvent{time: t, point: p, v: v, u: u}, false)
+		}
+	}
+	return best, found
+}
+
+// apply advances the wavefront to the time of the event, and changes its shape accordingly.
+func (s *skeleton) apply(e skeletonEvent) {
+	s.now = e.time
+	v := e.v
+	if e.u == nil {
+		w := v.next
+		s.replace(v, w, s.newVertex(e.point, e.time, v.left, w.right))
+		s.active = slices.DeleteFunc(s.active, func(v *skeletonVertex) bool { return v.removed })
+		return
+	}
+
+	// v splits the edge after u into two, on either side of it
+	u, edge := e.u, e.u.right
+	before, after, uNext := v.prev, v.next, u.next
+	first := s.newVertex(e.point, e.time, v.left, edge)
+	second := s.newVertex(e.point, e.time, edge, v.right)
+	s.addArc(v.origin, e.point)
+	v.removed = true
+	link(before, first)
+	link(first, uNext)
+	link(u, second)
+	link(second, after)
+	s.active = append(slices.DeleteFunc(s.active, func(v *skeletonVertex) bool { return v.removed }), first, second)
+}
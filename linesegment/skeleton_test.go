@@ -0,0 +1,139 @@
+package linesegment
+
+import (
+	"github.com/mikenye/geom2d/point"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"math"
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func TestStraightSkeleton(t *testing.T) {
+	split := 1.5 / (1 + math.Sqrt(1.01))
+	corner := 20 / (11 + math.Sqrt(101))
+	tests := map[string]struct {
+		ring     []point.Point
+		expected []LineSegment
+	}{
+		"square": {
+			ring: []point.Point{point.New(0, 0), point.New(2, 0), point.New(2, 2), point.New(0, 2)},
+			expected: []LineSegment{
+				New(0, 0, 1, 1), New(2, 0, 1, 1), New(2, 2, 1, 1), New(0, 2, 1, 1),
+			},
+		},
+		"clockwise rectangle": {
+			ring: []point.Point{point.New(0, 0), point.New(0, 2), point.New(4, 2), point.New(4, 0)},
+			expected: []LineSegment{
+				New(0, 0, 1, 1), New(0, 2, 1, 1), New(4, 2, 3, 1), New(4, 0, 3, 1), New(1, 1, 3, 1),
+			},
+		},
+		"right triangle": {
+			ring: []point.Point{point.New(0, 0), point.New(4, 0), point.New(0, 3)},
+			expected: []LineSegment{
+				New(0, 0, 1, 1), New(4, 0, 1, 1), New(0, 3, 1, 1),
+			},
+		},
+		"collinear vertex is ignored": {
+			ring: []point.Point{point.New(0, 0), point.New(1, 0), point.New(2, 0), point.New(2, 2), point.New(0, 2)},
+			expected: []LineSegment{
+				New(0, 0, 1, 1), New(2, 0, 1, 1), New(2, 2, 1, 1), New(0, 2, 1, 1),
+			},
+		},
+		"L shape": {
+			ring: []point.Point{
+				point.New(0, 0), point.New(2, 0), point.New(2, 1), point.New(1, 1), point.New(1, 2), point.New(0, 2),
+			},
+			expected: []LineSegment{
+				New(0, 0, 0.5, 0.5), New(1, 1, 0.5, 0.5),
+				New(2, 0, 1.5, 0.5), New(2, 1, 1.5, 0.5), New(1.5, 0.5, 0.5, 0.5),
+				New(1, 2, 0.5, 1.5), New(0, 2, 0.5, 1.5), New(0.5, 1.5, 0.5, 0.5),
+			},
+		},
+		"T shape": {
+			// the sides of the stem meet as its end shrinks away, leaving a sliver from (2,1) to (2,3)
+			ring: []point.Point{
+				point.New(1, 0), point.New(3, 0), point.New(3, 2), point.New(4, 2), point.New(4, 4),
+				point.New(0, 4), point.New(0, 2), point.New(1, 2),
+			},
+			expected: []LineSegment{
+				New(1, 0, 2, 1), New(3, 0, 2, 1), New(2, 1, 2, 3), New(1, 2, 2, 3), New(3, 2, 2, 3),
+				New(0, 2, 1, 3), New(0, 4, 1, 3), New(1, 3, 2, 3),
+				New(4, 2, 3, 3), New(4, 4, 3, 3), New(3, 3, 2, 3),
+			},
+		},
+		"reflex vertex splits the polygon": {
+			// the reflex vertex at (5,1.5) reaches the bottom edge at y=split, then each half collapses at a
+			// point equidistant from the bottom, a side and the top edge x+10y=20
+			ring: []point.Point{
+				point.New(0, 0), point.New(10, 0), point.New(10, 2), point.New(5, 1.5), point.New(0, 2),
+			},
+			expected: []LineSegment{
+				New(5, 1.5, 5, split), New(0, 0, corner, corner), New(0, 2, corner, corner), New(corner, corner, 5, split),
+				New(10, 0, 10-corner, corner), New(10, 2, 10-corner, corner), New(10-corner, corner, 5, split),
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			actual, err := StraightSkeleton(tc.ring)
+			require.NoError(t, err)
+			assert.Len(t, actual, len(tc.expected), "got %v", actual)
+			for _, exp := range tc.expected {
+				assert.True(t, slices.ContainsFunc(actual, exp.Eq), "expected %s in %v", exp, actual)
+			}
+		})
+	}
+}
+
+func TestStraightSkeleton_RandomPolygons(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := range 50 {
+		// a star-shaped polygon around the origin, with random radii
+		n := 5 + rng.Intn(20)
+		ring := make([]point.Point, n)
+		for j := range ring {
+			angle := 2 * math.Pi * (float64(j) + 0.5*rng.Float64()) / float64(n)
+			radius := 1 + 9*rng.Float64()
+			ring[j] = point.New(radius*math.Cos(angle), radius*math.Sin(angle))
+		}
+		require.True(t, point.IsSimplePolygon(ring), "polygon %d is not simple", i)
+
+		arcs, err := StraightSkeleton(ring)
+		require.NoError(t, err, "polygon %d", i)
+		assert.Len(t, arcs, 2*n-3, "polygon %d", i)
+
+		for _, p := range ring {
+			assert.True(t, slices.ContainsFunc(arcs, func(arc LineSegment) bool {
+				return arc.upper.Eq(p) || arc.lower.Eq(p)
+			}), "polygon %d: vertex %s is not the end of an arc", i, p)
+		}
+		for j, a := range arcs {
+			for _, b := range arcs[j+1:] {
+				points, ok := a.IntersectionPoints(b)
+				if !ok {
+					continue
+				}
+				for _, p := range points {
+					atEnd := p.DistanceToPoint(a.upper) < 1e-9 || p.DistanceToPoint(a.lower) < 1e-9
+					assert.True(t, atEnd, "polygon %d: arcs %s and %s cross at %s", i, a, b, p)
+				}
+			}
+		}
+	}
+}
+
+func TestStraightSkeleton_Errors(t *testing.T) {
+	tests := map[string][]point.Point{
+		"too few points":  {point.New(0, 0), point.New(1, 1)},
+		"collinear":       {point.New(0, 0), point.New(1, 1), point.New(2, 2)},
+		"self-intersects": {point.New(0, 0), point.New(2, 2), point.New(2, 0), point.New(0, 2)},
+	}
+	for name, ring := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := StraightSkeleton(ring)
+			assert.EqualError(t, err, "cannot compute straight skeleton: polygon is not simple")
+		})
+	}
+}
@@ -672,7 +672,7 @@ func findNewEvent(
 //   - A red-black tree mapping intersection points to the set of segments involved in each intersection
 //
 // Behavior:
-//   - First sanitizes the input to remove duplicate segments
+//   - First sanitizes the input to remove duplicate and degenerate segments
 //   - Iterates through all pairs of segments, checking each pair for intersection
 //   - Records all intersection points and their associated segments in the result tree
 //   - Uses the same result format as FindIntersections for consistency
@@ -756,7 +756,7 @@ func sanitizeInput(S []LineSegment) []LineSegment {
 	for _, seg := range S {
 
 		// skip degenerate segments
-		if seg.lower.Eq(seg.upper) {
+		if seg.IsDegenerate() {
 			continue
 		}
 		tmp[seg] = struct{}{}
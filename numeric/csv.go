@@ -0,0 +1,104 @@
+package numeric
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// ReadFloatCSV reads rows of floating-point values from CSV data.
+//
+// This is used by the CSV readers of the geometric types, such as point.ReadPointsCSV, to parse
+// simple numeric CSV files such as those produced by spreadsheets or numpy.
+//
+// Parameters:
+//   - r: The source of the CSV data.
+//   - columns: The number of values expected in every row.
+//
+// Returns:
+//   - The values of each row, in order.
+//   - An error if the data cannot be read, or if a row has the wrong number of fields or a field is not a number.
+//     The error includes the line number of the offending row.
+//
+// Behavior:
+//   - If no field of the first row is a number, that row is treated as a header and skipped.
+//   - Leading and trailing whitespace around each field is ignored.
+//   - Blank lines are skipped.
+func ReadFloatCSV(r io.Reader, columns int) ([][]float64, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1 // checked below, to give a clearer error
+	reader.TrimLeadingSpace = true
+
+	rows := make([][]float64, 0)
+	for first := true; ; first = false {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return rows, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		line, _ := reader.FieldPos(0)
+
+		if first && isCSVHeader(record) {
+			continue
+		}
+
+		if len(record) != columns {
+			return nil, fmt.Errorf("line %d: expected %d fields, got %d", line, columns, len(record))
+		}
+
+		row := make([]float64, columns)
+		for i, field := range record {
+			row[i], err = strconv.ParseFloat(strings.TrimSpace(field), 64)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: field %d: invalid number %q", line, i+1, field)
+			}
+		}
+		rows = append(rows, row)
+	}
+}
+
+// isCSVHeader reports whether a CSV record looks like a header row, that is, none of its fields are numbers.
+func isCSVHeader(record []string) bool {
+	for _, field := range record {
+		if _, err := strconv.ParseFloat(strings.TrimSpace(field), 64); err == nil {
+			return false
+		}
+	}
+	return true
+}
+
+// WriteFloatCSV writes rows of floating-point values as CSV data.
+//
+// This is used by the CSV writers of the geometric types, such as point.WritePointsCSV.
+//
+// Parameters:
+//   - w: The destination of the CSV data.
+//   - rows: The values of each row.
+//
+// Returns:
+//   - An error if the data cannot be written.
+//
+// Behavior:
+//   - No header row is written.
+//   - Values are written with the smallest number of digits necessary to represent them exactly,
+//     so that reading them back with [ReadFloatCSV] gives the same values.
+func WriteFloatCSV(w io.Writer, rows [][]float64) error {
+	writer := csv.NewWriter(w)
+	record := make([]string, 0)
+	for _, row := range rows {
+		record = record[:0]
+		for _, value := range row {
+			record = append(record, strconv.FormatFloat(value, 'g', -1, 64))
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
@@ -0,0 +1,104 @@
+package numeric
+
+import (
+	"bytes"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"strings"
+	"testing"
+)
+
+func TestReadFloatCSV(t *testing.T) {
+	tests := map[string]struct {
+		input       string
+		columns     int
+		expected    [][]float64
+		expectedErr string
+	}{
+		"no header": {
+			input:    "1,2\n3.5,-4\n",
+			columns:  2,
+			expected: [][]float64{{1, 2}, {3.5, -4}},
+		},
+		"header is skipped": {
+			input:    "x,y\n1,2\n",
+			columns:  2,
+			expected: [][]float64{{1, 2}},
+		},
+		"whitespace and blank lines": {
+			input:    " 1 , 2\n\n3,4 \n",
+			columns:  2,
+			expected: [][]float64{{1, 2}, {3, 4}},
+		},
+		"exponent notation": {
+			input:    "1e-3,2E+2\n",
+			columns:  2,
+			expected: [][]float64{{0.001, 200}},
+		},
+		"empty": {
+			input:    "",
+			columns:  2,
+			expected: [][]float64{},
+		},
+		"header only": {
+			input:    "x,y\n",
+			columns:  2,
+			expected: [][]float64{},
+		},
+		"wrong number of fields": {
+			input:       "x,y\n1,2\n3,4,5\n",
+			columns:     2,
+			expectedErr: "line 3: expected 2 fields, got 3",
+		},
+		"invalid number": {
+			input:       "1,2\n3,abc\n",
+			columns:     2,
+			expectedErr: `line 2: field 2: invalid number "abc"`,
+		},
+		"partially numeric first row is not a header": {
+			input:       "1,y\n",
+			columns:     2,
+			expectedErr: `line 1: field 2: invalid number "y"`,
+		},
+		"header only skipped on first row": {
+			input:       "1,2\nx,y\n",
+			columns:     2,
+			expectedErr: `line 2: field 1: invalid number "x"`,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			actual, err := ReadFloatCSV(strings.NewReader(tt.input), tt.columns)
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestWriteFloatCSV(t *testing.T) {
+	rows := [][]float64{{1, 2}, {0.1, -3.25}, {1e21, 1.0 / 3}}
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteFloatCSV(&buf, rows))
+	assert.Equal(t, "1,2\n0.1,-3.25\n1e+21,0.3333333333333333\n", buf.String())
+
+	actual, err := ReadFloatCSV(&buf, 2)
+	require.NoError(t, err)
+	assert.Equal(t, rows, actual)
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) {
+	return 0, errors.New("write failed")
+}
+
+func TestWriteFloatCSV_WriteError(t *testing.T) {
+	assert.Error(t, WriteFloatCSV(failingWriter{}, [][]float64{{1, 2}}))
+}
@@ -0,0 +1,22 @@
+package numeric
+
+import "strconv"
+
+// FormatFloat formats a floating-point value as a decimal string with a fixed number of decimal places.
+//
+// This is used by the StringWithPrecision methods of the geometric types to produce
+// compact, readable output when debugging floating-point geometry.
+//
+// Parameters:
+//   - value: The floating-point number to format.
+//   - decimals: The number of digits to print after the decimal point. If negative, the smallest
+//     number of digits necessary to represent the value exactly is used.
+//
+// Returns:
+//   - The formatted value, without an exponent (for example "1.50" or "-0.3333").
+func FormatFloat(value float64, decimals int) string {
+	if decimals < 0 {
+		decimals = -1
+	}
+	return strconv.FormatFloat(value, 'f', decimals, 64)
+}
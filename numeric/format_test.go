@@ -0,0 +1,29 @@
+package numeric
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestFormatFloat(t *testing.T) {
+	tests := map[string]struct {
+		value    float64
+		decimals int
+		expected string
+	}{
+		"two decimals":               {value: 1.23456, decimals: 2, expected: "1.23"},
+		"rounds to nearest":          {value: 2.66, decimals: 1, expected: "2.7"},
+		"pads with zeros":            {value: 1.5, decimals: 3, expected: "1.500"},
+		"zero decimals":              {value: 2.6, decimals: 0, expected: "3"},
+		"negative value":             {value: -0.333333, decimals: 4, expected: "-0.3333"},
+		"negative decimals shortest": {value: 0.1, decimals: -1, expected: "0.1"},
+		"very negative decimals":     {value: 1234.5678, decimals: -5, expected: "1234.5678"},
+		"no exponent for large":      {value: 1e21, decimals: 0, expected: "1000000000000000000000"},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, FormatFloat(tt.value, tt.decimals))
+		})
+	}
+}
@@ -26,6 +26,12 @@
 //     they are within an acceptable tolerance, reducing small precision
 //     artifacts.
 //
+//   - Formatting: The FormatFloat function formats floating-point numbers
+//     with a fixed number of decimal places, for compact debugging output.
+//
+//   - CSV: The ReadFloatCSV and WriteFloatCSV functions read and write
+//     rows of floating-point numbers, for simple data interchange.
+//
 // # Usage
 //
 // This package is particularly useful in scenarios where direct equality
@@ -0,0 +1,175 @@
+package point
+
+import (
+	"fmt"
+	"math"
+)
+
+// IsClockwise reports whether a ring of points winds clockwise.
+//
+// Parameters:
+//   - ring ([]Point): The vertices of the ring, in order. The first point should not be repeated at the end.
+//
+// Returns:
+//   - bool: true if the signed area of the ring is negative, false otherwise.
+//
+// Behavior:
+//   - The signed area is computed using the shoelace formula, in the usual mathematical convention of the
+//     Y-axis pointing up: counterclockwise rings have positive area, and clockwise rings negative area.
+//     In screen coordinates, where the Y-axis points down, a ring that appears counterclockwise on screen
+//     is clockwise by this definition.
+//   - Rings with fewer than three points, or with zero area such as when all points are collinear, are
+//     neither clockwise nor counterclockwise, and both IsClockwise and [IsCounterclockwise] return false.
+//   - For a self-intersecting ring, the result reflects the net area, and may not describe every part of it.
+func IsClockwise(ring []Point) bool {
+	return signedArea2X(ring) < 0
+}
+
+// IsCounterclockwise reports whether a ring of points winds counterclockwise.
+//
+// Parameters:
+//   - ring ([]Point): The vertices of the ring, in order. The first point should not be repeated at the end.
+//
+// Returns:
+//   - bool: true if the signed area of the ring is positive, false otherwise.
+//
+// Behavior:
+//   - The sign convention is as for [IsClockwise]. Rings that enclose no area return false.
+//   - Functions in this package that return rings, such as [ConvexHull], return them counterclockwise.
+func IsCounterclockwise(ring []Point) bool {
+	return signedArea2X(ring) > 0
+}
+
+// PolygonArea calculates the net area of a polygon defined by an outer ring of points and zero or more holes.
+//
+// This is convenient when working with raw rings of points, without needing to construct a higher-level
+// polygon type.
+//
+// Parameters:
+//   - outer ([]Point): The vertices of the outer boundary, in order. The first point should not be repeated at the end.
+//   - holes (...[]Point): The vertices of each hole, in order. The first point should not be repeated at the end.
+//
+// Returns:
+//   - float64: The area of the outer ring minus the area of each hole. This is always non-negative for
+//     well-formed input.
+//
+// Behavior:
+//   - The area of each ring is computed using the shoelace formula.
+//   - The winding direction (clockwise or counterclockwise) of the outer ring and of each hole does not matter:
+//     the absolute area of each hole is always subtracted from the absolute area of the outer ring.
+//   - Rings with fewer than three points have zero area.
+//
+// Notes:
+//   - Holes are assumed to lie within the outer ring and not to overlap each other. This is not validated.
+func PolygonArea(outer []Point, holes ...[]Point) float64 {
+	area := math.Abs(signedArea2X(outer))
+	for _, hole := range holes {
+		area -= math.Abs(signedArea2X(hole))
+	}
+	return area / 2
+}
+
+// PolygonCentroid calculates the centroid (center of area) of a polygon defined by an outer ring of points
+// and zero or more holes.
+//
+// Parameters:
+//   - outer ([]Point): The vertices of the outer boundary, in order. The first point should not be repeated at the end.
+//   - holes (...[]Point): The vertices of each hole, in order. The first point should not be repeated at the end.
+//
+// Returns:
+//   - Point: The centroid of the area enclosed by the outer ring, excluding the holes.
+//   - error: An error if the polygon has zero area, as its centroid is then undefined.
+//
+// Behavior:
+//   - As for [PolygonArea], the winding direction of each ring does not matter, and holes are subtracted.
+//   - The centroid of a non-convex polygon, or of one with holes, may lie outside the polygon itself.
+func PolygonCentroid(outer []Point, holes ...[]Point) (Point, error) {
+	m := polygonMoments(outer, holes)
+	if m.area == 0 {
+		return Point{}, fmt.Errorf("cannot compute centroid: polygon has zero area")
+	}
+	return New(m.origin.x+m.sx/m.area, m.origin.y+m.sy/m.area), nil
+}
+
+// PolygonSecondMomentOfArea calculates the second moments of area of a polygon defined by an outer ring of points
+// and zero or more holes, about axes through its centroid parallel to the X and Y axes.
+//
+// Together with [PolygonArea] and [PolygonCentroid], this gives the mass properties of a rigid 2D body
+// of uniform density: multiply each moment by the density to obtain the moment of inertia.
+//
+// Parameters:
+//   - outer ([]Point): The vertices of the outer boundary, in order. The first point should not be repeated at the end.
+//   - holes (...[]Point): The vertices of each hole, in order. The first point should not be repeated at the end.
+//
+// Returns:
+//   - ix (float64): The second moment about the horizontal axis through the centroid, ∫(y - cy)² dA.
+//   - iy (float64): The second moment about the vertical axis through the centroid, ∫(x - cx)² dA.
+//   - ixy (float64): The product of area about the centroid, ∫(x - cx)(y - cy) dA. This is zero for shapes
+//     symmetric about either axis.
+//   - err (error): An error if the polygon has zero area, as its centroid is then undefined.
+//
+// Behavior:
+//   - The moments of each ring are computed from the standard polygon integrals (the shoelace formula
+//     generalised to higher moments), and shifted to the centroid using the parallel axis theorem.
+//   - As for [PolygonArea], the winding direction of each ring does not matter, and holes are subtracted.
+//   - The polar moment of area, about an axis through the centroid perpendicular to the plane, is ix + iy.
+func PolygonSecondMomentOfArea(outer []Point, holes ...[]Point) (ix, iy, ixy float64, err error) {
+	m := polygonMoments(outer, holes)
+	if m.area == 0 {
+		return 0, 0, 0, fmt.Errorf("cannot compute second moment of area: polygon has zero area")
+	}
+	cx, cy := m.sx/m.area, m.sy/m.area
+	return m.syy - m.area*cy*cy, m.sxx - m.area*cx*cx, m.sxy - m.area*cx*cy, nil
+}
+
+// moments holds the area integrals of a polygon, taken relative to origin to reduce rounding error:
+// the area itself, the first moments ∫x dA and ∫y dA, and the second moments ∫x² dA, ∫y² dA and ∫xy dA.
+type moments struct {
+	origin                      Point
+	area, sx, sy, sxx, syy, sxy float64
+}
+
+// polygonMoments computes the area integrals of the outer ring, less those of each hole, relative to the
+// first point of the outer ring. The winding of each ring is ignored.
+func polygonMoments(outer []Point, holes [][]Point) moments {
+	m := moments{}
+	if len(outer) > 0 {
+		m.origin = outer[0]
+	}
+	m.add(outer, 1)
+	for _, hole := range holes {
+		m.add(hole, -1)
+	}
+	return m
+}
+
+// add adds the area integrals of ring to m, scaled by sign, after making the ring's own orientation positive.
+func (m *moments) add(ring []Point, sign float64) {
+	if signedArea2X(ring) < 0 {
+		sign = -sign
+	}
+	for i := range ring {
+		p, q := ring[i].Sub(m.origin), ring[(i+1)%len(ring)].Sub(m.origin)
+		cross := sign * p.CrossProduct(q)
+		m.area += cross / 2
+		m.sx += (p.x + q.x) * cross / 6
+		m.sy += (p.y + q.y) * cross / 6
+		m.sxx += (p.x*p.x + p.x*q.x + q.x*q.x) * cross / 12
+		m.syy += (p.y*p.y + p.y*q.y + q.y*q.y) * cross / 12
+		m.sxy += (p.x*q.y + 2*p.x*p.y + 2*q.x*q.y + q.x*p.y) * cross / 24
+	}
+}
+
+// signedArea2X returns twice the signed area of the ring of points, using the shoelace formula.
+// The result is positive for counterclockwise rings and negative for clockwise rings.
+func signedArea2X(ring []Point) float64 {
+	if len(ring) < 3 {
+		return 0
+	}
+	var area float64
+	for i, p := range ring {
+		q := ring[(i+1)%len(ring)]
+		area += p.CrossProduct(q)
+	}
+	return area
+}
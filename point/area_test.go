@@ -0,0 +1,189 @@
+package point
+
+import (
+	"github.com/mikenye/geom2d"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"math"
+	"testing"
+)
+
+func TestIsClockwise(t *testing.T) {
+	tests := map[string]struct {
+		ring                        []Point
+		clockwise, counterclockwise bool
+	}{
+		"counterclockwise square": {
+			ring:             []Point{New(0, 0), New(1, 0), New(1, 1), New(0, 1)},
+			counterclockwise: true,
+		},
+		"clockwise square": {
+			ring:      []Point{New(0, 0), New(0, 1), New(1, 1), New(1, 0)},
+			clockwise: true,
+		},
+		"clockwise concave ring": {
+			ring:      []Point{New(0, 0), New(0, 4), New(4, 4), New(1, 2), New(4, 0)},
+			clockwise: true,
+		},
+		"collinear": {
+			ring: []Point{New(0, 0), New(1, 1), New(2, 2)},
+		},
+		"too few points": {
+			ring: []Point{New(0, 0), New(1, 1)},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.clockwise, IsClockwise(tc.ring), "IsClockwise")
+			assert.Equal(t, tc.counterclockwise, IsCounterclockwise(tc.ring), "IsCounterclockwise")
+		})
+	}
+}
+
+func TestPolygonArea(t *testing.T) {
+	square := []Point{New(0, 0), New(10, 0), New(10, 10), New(0, 10)}
+	squareCW := []Point{New(0, 0), New(0, 10), New(10, 10), New(10, 0)}
+	holeCCW := []Point{New(1, 1), New(3, 1), New(3, 3), New(1, 3)}
+	holeCW := []Point{New(5, 5), New(5, 8), New(8, 8), New(8, 5)}
+
+	tests := map[string]struct {
+		outer    []Point
+		holes    [][]Point
+		expected float64
+	}{
+		"square, counterclockwise": {
+			outer:    square,
+			expected: 100,
+		},
+		"square, clockwise": {
+			outer:    squareCW,
+			expected: 100,
+		},
+		"triangle": {
+			outer:    []Point{New(0, 0), New(4, 0), New(0, 3)},
+			expected: 6,
+		},
+		"square with one hole": {
+			outer:    square,
+			holes:    [][]Point{holeCCW},
+			expected: 96,
+		},
+		"square with two holes of mixed winding": {
+			outer:    square,
+			holes:    [][]Point{holeCCW, holeCW},
+			expected: 87,
+		},
+		"clockwise square with two holes": {
+			outer:    squareCW,
+			holes:    [][]Point{holeCW, holeCCW},
+			expected: 87,
+		},
+		"degenerate outer ring": {
+			outer:    []Point{New(0, 0), New(1, 1)},
+			expected: 0,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			actual := PolygonArea(tc.outer, tc.holes...)
+			assert.InDelta(t, tc.expected, actual, geom2d.GetEpsilon())
+		})
+	}
+}
+
+func TestPolygonCentroid(t *testing.T) {
+	square := []Point{New(0, 0), New(10, 0), New(10, 10), New(0, 10)}
+
+	tests := map[string]struct {
+		outer    []Point
+		holes    [][]Point
+		expected Point
+	}{
+		"square": {
+			outer:    square,
+			expected: New(5, 5),
+		},
+		"clockwise triangle": {
+			outer:    []Point{New(0, 0), New(0, 3), New(6, 0)},
+			expected: New(2, 1),
+		},
+		"square with an off-centre hole": {
+			outer:    square,
+			holes:    [][]Point{{New(5, 0), New(10, 0), New(10, 10), New(5, 10)}},
+			expected: New(2.5, 5),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			actual, err := PolygonCentroid(tc.outer, tc.holes...)
+			require.NoError(t, err)
+			assert.True(t, tc.expected.Eq(actual), "expected %s, got %s", tc.expected, actual)
+		})
+	}
+
+	_, err := PolygonCentroid([]Point{New(0, 0), New(1, 1), New(2, 2)})
+	assert.EqualError(t, err, "cannot compute centroid: polygon has zero area")
+}
+
+func TestPolygonSecondMomentOfArea(t *testing.T) {
+	// a 4x2 rectangle, away from the origin so that the parallel axis theorem is exercised
+	rectangle := []Point{New(10, 20), New(14, 20), New(14, 22), New(10, 22)}
+
+	circle, err := RegularPolygon(New(3, -2), 2, 2000, 0)
+	require.NoError(t, err)
+
+	tests := map[string]struct {
+		outer                  []Point
+		holes                  [][]Point
+		ix, iy, ixy, tolerance float64
+	}{
+		"rectangle": {
+			outer:     rectangle,
+			ix:        4 * 2 * 2 * 2 / 12.0,
+			iy:        2 * 4 * 4 * 4 / 12.0,
+			tolerance: geom2d.GetEpsilon(),
+		},
+		"clockwise rectangle": {
+			outer:     []Point{New(10, 20), New(10, 22), New(14, 22), New(14, 20)},
+			ix:        4 * 2 * 2 * 2 / 12.0,
+			iy:        2 * 4 * 4 * 4 / 12.0,
+			tolerance: geom2d.GetEpsilon(),
+		},
+		"square with a centred square hole": {
+			outer:     []Point{New(0, 0), New(4, 0), New(4, 4), New(0, 4)},
+			holes:     [][]Point{{New(1, 1), New(3, 1), New(3, 3), New(1, 3)}},
+			ix:        (256 - 16) / 12.0,
+			iy:        (256 - 16) / 12.0,
+			tolerance: geom2d.GetEpsilon(),
+		},
+		"right triangle has a product of area": {
+			outer:     []Point{New(0, 0), New(6, 0), New(0, 3)},
+			ix:        6 * 3 * 3 * 3 / 36.0,
+			iy:        3 * 6 * 6 * 6 / 36.0,
+			ixy:       -6 * 6 * 3 * 3 / 72.0,
+			tolerance: geom2d.GetEpsilon(),
+		},
+		"polygon approximating a circle": {
+			outer:     circle,
+			ix:        math.Pi * 16 / 4,
+			iy:        math.Pi * 16 / 4,
+			tolerance: 1e-4,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ix, iy, ixy, err := PolygonSecondMomentOfArea(tc.outer, tc.holes...)
+			require.NoError(t, err)
+			assert.InDelta(t, tc.ix, ix, tc.tolerance, "ix")
+			assert.InDelta(t, tc.iy, iy, tc.tolerance, "iy")
+			assert.InDelta(t, tc.ixy, ixy, tc.tolerance, "ixy")
+		})
+	}
+
+	_, _, _, err = PolygonSecondMomentOfArea([]Point{New(0, 0), New(1, 0)})
+	assert.EqualError(t, err, "cannot compute second moment of area: polygon has zero area")
+}
@@ -0,0 +1,106 @@
+package point
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"slices"
+)
+
+// ClosestPair finds the two closest points in a set of points.
+//
+// This uses the classic divide-and-conquer algorithm, which runs in O(n log n) time rather than
+// the O(n²) time of comparing every pair of points. It is useful for clustering and deduplication.
+//
+// Parameters:
+//   - points ([]Point): The set of points to search. The slice is not modified.
+//
+// Returns:
+//   - Point: The first point of the closest pair.
+//   - Point: The second point of the closest pair.
+//   - float64: The Euclidean distance between the two points.
+//   - error: An error if fewer than two points are given.
+//
+// Behavior:
+//   - If several pairs share the smallest distance, any one of them may be returned.
+//   - If the set contains duplicate points, a pair of duplicates is returned with a distance of 0.
+func ClosestPair(points []Point) (Point, Point, float64, error) {
+	if len(points) < 2 {
+		return Point{}, Point{}, 0, fmt.Errorf("at least 2 points are required to find the closest pair, got %d", len(points))
+	}
+
+	byX := slices.Clone(points)
+	slices.SortFunc(byX, func(a, b Point) int {
+		return cmp.Or(cmp.Compare(a.x, b.x), cmp.Compare(a.y, b.y))
+	})
+
+	a, b, distSquared := closestPairRecursive(byX, make([]Point, len(byX)))
+	return a, b, math.Sqrt(distSquared), nil
+}
+
+// closestPairBruteForceThreshold is the number of points at or below which
+// closestPairRecursive compares every pair directly.
+const closestPairBruteForceThreshold = 3
+
+// closestPairRecursive finds the closest pair in points, which must be sorted by x-coordinate, returning
+// the pair and their squared distance. On return, points is sorted by y-coordinate instead.
+// The scratch slice must be at least as long as points.
+func closestPairRecursive(points, scratch []Point) (Point, Point, float64) {
+	n := len(points)
+	if n <= closestPairBruteForceThreshold {
+		a, b, best := points[0], points[1], math.Inf(1)
+		for i := 0; i < n; i++ {
+			for j := i + 1; j < n; j++ {
+				if d := points[i].DistanceSquaredToPoint(points[j]); d < best {
+					a, b, best = points[i], points[j], d
+				}
+			}
+		}
+		slices.SortFunc(points, func(p, q Point) int { return cmp.Compare(p.y, q.y) })
+		return a, b, best
+	}
+
+	mid := n / 2
+	midX := points[mid].x
+
+	a, b, best := closestPairRecursive(points[:mid], scratch)
+	if ra, rb, rd := closestPairRecursive(points[mid:], scratch); rd < best {
+		a, b, best = ra, rb, rd
+	}
+
+	// merge the two halves, which are now each sorted by y
+	merged := scratch[:0]
+	i, j := 0, mid
+	for i < mid || j < n {
+		if j >= n || (i < mid && points[i].y <= points[j].y) {
+			merged = append(merged, points[i])
+			i++
+		} else {
+			merged = append(merged, points[j])
+			j++
+		}
+	}
+	copy(points, merged)
+
+	// check pairs straddling the dividing line, within the strip of width 2δ around it
+	strip := scratch[:0]
+	for _, p := range points {
+		dx := p.x - midX
+		if dx*dx < best {
+			strip = append(strip, p)
+		}
+	}
+	for i := range strip {
+		for j := i + 1; j < len(strip); j++ {
+			dy := strip[j].y - strip[i].y
+			if dy*dy >= best {
+				break
+			}
+			if d := strip[i].DistanceSquaredToPoint(strip[j]); d < best {
+				a, b, best = strip[i], strip[j], d
+			}
+		}
+	}
+
+	return a, b, best
+}
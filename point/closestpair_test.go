@@ -0,0 +1,92 @@
+package point
+
+import (
+	"github.com/mikenye/geom2d"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestClosestPair(t *testing.T) {
+	tests := map[string]struct {
+		points           []Point
+		expectedA        Point
+		expectedB        Point
+		expectedDistance float64
+	}{
+		"two points": {
+			points:           []Point{New(0, 0), New(3, 4)},
+			expectedA:        New(0, 0),
+			expectedB:        New(3, 4),
+			expectedDistance: 5,
+		},
+		"closest pair in the same half": {
+			points:           []Point{New(0, 0), New(10, 10), New(1, 1), New(20, 0), New(30, 5), New(40, 40)},
+			expectedA:        New(0, 0),
+			expectedB:        New(1, 1),
+			expectedDistance: math.Sqrt2,
+		},
+		"closest pair straddles the dividing line": {
+			points:           []Point{New(0, 0), New(1, 10), New(2, 20), New(3.1, 5), New(3.3, 5.1), New(5, 30), New(6, 0), New(7, 15)},
+			expectedA:        New(3.1, 5),
+			expectedB:        New(3.3, 5.1),
+			expectedDistance: math.Hypot(0.2, 0.1),
+		},
+		"vertical line of points": {
+			points:           []Point{New(0, 0), New(0, 5), New(0, 9), New(0, 12), New(0, 20)},
+			expectedA:        New(0, 9),
+			expectedB:        New(0, 12),
+			expectedDistance: 3,
+		},
+		"duplicate points": {
+			points:           []Point{New(5, 5), New(1, 2), New(8, 1), New(1, 2)},
+			expectedA:        New(1, 2),
+			expectedB:        New(1, 2),
+			expectedDistance: 0,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			original := make([]Point, len(tc.points))
+			copy(original, tc.points)
+
+			a, b, distance, err := ClosestPair(tc.points)
+			require.NoError(t, err)
+			assert.InDelta(t, tc.expectedDistance, distance, geom2d.GetEpsilon())
+			assert.ElementsMatch(t, []Point{tc.expectedA, tc.expectedB}, []Point{a, b})
+			assert.Equal(t, original, tc.points, "input should not be modified")
+		})
+	}
+
+	t.Run("fewer than two points", func(t *testing.T) {
+		_, _, _, err := ClosestPair([]Point{New(1, 1)})
+		assert.Error(t, err)
+		_, _, _, err = ClosestPair(nil)
+		assert.Error(t, err)
+	})
+
+	t.Run("matches brute force", func(t *testing.T) {
+		rng := rand.New(rand.NewSource(1))
+		for iteration := 0; iteration < 50; iteration++ {
+			points := make([]Point, 2+rng.Intn(200))
+			for i := range points {
+				points[i] = New(rng.Float64()*100, rng.Float64()*100)
+			}
+
+			expected := math.Inf(1)
+			for i := range points {
+				for j := i + 1; j < len(points); j++ {
+					expected = min(expected, points[i].DistanceToPoint(points[j]))
+				}
+			}
+
+			a, b, distance, err := ClosestPair(points)
+			require.NoError(t, err)
+			assert.InDelta(t, expected, distance, geom2d.GetEpsilon())
+			assert.InDelta(t, distance, a.DistanceToPoint(b), geom2d.GetEpsilon())
+		}
+	})
+}
@@ -0,0 +1,104 @@
+package point
+
+import "fmt"
+
+// Convexity describes the interior angle of a polygon at one of its vertices.
+//
+// Convex and reflex vertices matter to algorithms such as ear-clipping triangulation and convex
+// decomposition: an ear can only be clipped at a convex vertex, and a polygon is convex exactly when
+// it has no reflex vertices.
+type Convexity uint8
+
+// Convexity constants define the possible classifications of a polygon vertex.
+const (
+	// Straight indicates that a vertex is collinear with its neighbors, with an interior angle of 180°.
+	Straight Convexity = iota
+
+	// Convex indicates that a vertex has an interior angle of less than 180°.
+	Convex
+
+	// Reflex indicates that a vertex has an interior angle of more than 180°.
+	Reflex
+)
+
+// String returns a human-readable string representation of the convexity.
+//
+// Returns:
+//   - string: The name of the convexity: "Straight", "Convex", or "Reflex".
+//
+// Panics:
+//   - If the Convexity value is not one of the defined constants.
+func (c Convexity) String() string {
+	switch c {
+	case Straight:
+		return "Straight"
+	case Convex:
+		return "Convex"
+	case Reflex:
+		return "Reflex"
+	default:
+		panic(fmt.Errorf("unsupported convexity: %d", c))
+	}
+}
+
+// VertexInfo describes one vertex of a polygon ring, as returned by [VertexConvexity].
+type VertexInfo struct {
+	// Index is the position of the vertex in the ring.
+	Index int
+
+	// Point is the vertex itself.
+	Point Point
+
+	// Convexity classifies the interior angle of the polygon at the vertex.
+	Convexity Convexity
+}
+
+// VertexConvexity classifies each vertex of a ring of points as convex, reflex or straight, relative to
+// the interior of the polygon the ring bounds.
+//
+// Parameters:
+//   - ring ([]Point): The vertices of the ring, in order. The first point should not be repeated at the end.
+//   - isHole (bool): Whether the ring is a hole. The interior of the polygon then lies outside the ring,
+//     rather than inside it.
+//
+// Returns:
+//   - []VertexInfo: One entry per vertex, in the order of the ring.
+//   - error: An error if the ring has fewer than three points or encloses no area, as its interior is then undefined.
+//
+// Behavior:
+//   - The turn at each vertex is found using [Orientation], and compared with the winding direction of the ring,
+//     as determined by [IsCounterclockwise]. So the winding direction the ring is stored in does not matter:
+//     a hole is classified correctly whether it is wound opposite to its outer ring, as is conventional, or not.
+//   - For a hole, convex and reflex are swapped relative to an outer ring of the same shape, as a corner that
+//     points into the hole is a reflex vertex of the polygon around it.
+//   - Vertices collinear with their neighbors, within the epsilon tolerance of [Orientation], are [Straight].
+//     This includes a vertex repeated consecutively.
+//   - The ring is assumed to be simple. See [IsSimplePolygon].
+func VertexConvexity(ring []Point, isHole bool) ([]VertexInfo, error) {
+	if len(ring) < 3 {
+		return nil, fmt.Errorf("a ring must have at least 3 points, got %d", len(ring))
+	}
+	area := signedArea2X(ring)
+	if area == 0 {
+		return nil, fmt.Errorf("cannot classify vertices: ring of %d points encloses no area", len(ring))
+	}
+
+	// the turn direction at a convex vertex, following the ring in its stored order
+	convexTurn := Counterclockwise
+	if (area < 0) != isHole {
+		convexTurn = Clockwise
+	}
+
+	n := len(ring)
+	vertices := make([]VertexInfo, n)
+	for i, p := range ring {
+		vertices[i] = VertexInfo{Index: i, Point: p, Convexity: Reflex}
+		switch Orientation(ring[(i+n-1)%n], p, ring[(i+1)%n]) {
+		case Collinear:
+			vertices[i].Convexity = Straight
+		case convexTurn:
+			vertices[i].Convexity = Convex
+		}
+	}
+	return vertices, nil
+}
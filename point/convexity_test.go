@@ -0,0 +1,74 @@
+package point
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"slices"
+	"testing"
+)
+
+func TestConvexity_String(t *testing.T) {
+	assert.Equal(t, "Straight", Straight.String())
+	assert.Equal(t, "Convex", Convex.String())
+	assert.Equal(t, "Reflex", Reflex.String())
+	assert.Panics(t, func() {
+		_ = Convexity(255).String()
+	})
+}
+
+func TestVertexConvexity(t *testing.T) {
+	// an L shape, with its only reflex corner at (1, 1)
+	lShape := []Point{New(0, 0), New(2, 0), New(2, 1), New(1, 1), New(1, 2), New(0, 2)}
+	lShapeCW := slices.Clone(lShape)
+	slices.Reverse(lShapeCW)
+
+	tests := map[string]struct {
+		ring     []Point
+		isHole   bool
+		expected []Convexity
+	}{
+		"counterclockwise L shape": {
+			ring:     lShape,
+			expected: []Convexity{Convex, Convex, Convex, Reflex, Convex, Convex},
+		},
+		"clockwise L shape": {
+			ring:     lShapeCW,
+			expected: []Convexity{Convex, Convex, Reflex, Convex, Convex, Convex},
+		},
+		"L shaped hole, wound clockwise": {
+			ring:     lShapeCW,
+			isHole:   true,
+			expected: []Convexity{Reflex, Reflex, Convex, Reflex, Reflex, Reflex},
+		},
+		"L shaped hole, wound counterclockwise": {
+			ring:     lShape,
+			isHole:   true,
+			expected: []Convexity{Reflex, Reflex, Reflex, Convex, Reflex, Reflex},
+		},
+		"straight vertex": {
+			ring:     []Point{New(0, 0), New(1, 0), New(2, 0), New(2, 2), New(0, 2)},
+			expected: []Convexity{Convex, Straight, Convex, Convex, Convex},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			vertices, err := VertexConvexity(tc.ring, tc.isHole)
+			require.NoError(t, err)
+			require.Len(t, vertices, len(tc.expected))
+			for i, v := range vertices {
+				assert.Equal(t, i, v.Index)
+				assert.Equal(t, tc.ring[i], v.Point)
+				assert.Equal(t, tc.expected[i], v.Convexity, "vertex %d at %s", i, v.Point)
+			}
+		})
+	}
+}
+
+func TestVertexConvexity_Errors(t *testing.T) {
+	_, err := VertexConvexity([]Point{New(0, 0), New(1, 0)}, false)
+	assert.EqualError(t, err, "a ring must have at least 3 points, got 2")
+
+	_, err = VertexConvexity([]Point{New(0, 0), New(1, 1), New(2, 2)}, false)
+	assert.EqualError(t, err, "cannot classify vertices: ring of 3 points encloses no area")
+}
@@ -0,0 +1,52 @@
+package point
+
+import (
+	"github.com/mikenye/geom2d/numeric"
+	"io"
+)
+
+// ReadPointsCSV reads a list of points from CSV data.
+//
+// Each row holds one point, as its X- and Y-coordinates: "x,y". This is the format written by
+// [WritePointsCSV], and is convenient for exchanging data with spreadsheets and tools such as numpy.
+//
+// Parameters:
+//   - r (io.Reader): The source of the CSV data.
+//
+// Returns:
+//   - []Point: The points, in the order they were read.
+//   - error: An error if the data cannot be read, or if a row is malformed. The error includes the line number of the row.
+//
+// Behavior:
+//   - An optional header row (such as "x,y") is detected and skipped.
+//   - See [numeric.ReadFloatCSV] for details of how rows are parsed.
+func ReadPointsCSV(r io.Reader) ([]Point, error) {
+	rows, err := numeric.ReadFloatCSV(r, 2)
+	if err != nil {
+		return nil, err
+	}
+	points := make([]Point, len(rows))
+	for i, row := range rows {
+		points[i] = New(row[0], row[1])
+	}
+	return points, nil
+}
+
+// WritePointsCSV writes a list of points as CSV data.
+//
+// Each point is written as one row holding its X- and Y-coordinates: "x,y". No header row is written.
+// The output can be read back using [ReadPointsCSV].
+//
+// Parameters:
+//   - w (io.Writer): The destination of the CSV data.
+//   - points ([]Point): The points to write.
+//
+// Returns:
+//   - error: An error if the data cannot be written.
+func WritePointsCSV(w io.Writer, points []Point) error {
+	rows := make([][]float64, len(points))
+	for i, p := range points {
+		rows[i] = []float64{p.x, p.y}
+	}
+	return numeric.WriteFloatCSV(w, rows)
+}
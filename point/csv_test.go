@@ -0,0 +1,54 @@
+package point
+
+import (
+	"bytes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"strings"
+	"testing"
+)
+
+func TestReadPointsCSV(t *testing.T) {
+	tests := map[string]struct {
+		input       string
+		expected    []Point
+		expectedErr string
+	}{
+		"with header": {
+			input:    "x,y\n1,2\n-3,4.5\n",
+			expected: []Point{New(1, 2), New(-3, 4.5)},
+		},
+		"without header": {
+			input:    "1,2\n",
+			expected: []Point{New(1, 2)},
+		},
+		"malformed row": {
+			input:       "x,y\n1,2\n3\n",
+			expectedErr: "line 3: expected 2 fields, got 1",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			actual, err := ReadPointsCSV(strings.NewReader(tc.input))
+			if tc.expectedErr != "" {
+				assert.EqualError(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestWritePointsCSV(t *testing.T) {
+	points := []Point{New(1, 2), New(-0.5, 1e-7)}
+
+	var buf bytes.Buffer
+	require.NoError(t, WritePointsCSV(&buf, points))
+	assert.Equal(t, "1,2\n-0.5,1e-07\n", buf.String())
+
+	actual, err := ReadPointsCSV(&buf)
+	require.NoError(t, err)
+	assert.Equal(t, points, actual)
+}
@@ -0,0 +1,462 @@
+package point
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"slices"
+)
+
+// HullAlgorithm selects the algorithm used by [ConvexHull] to compute a convex hull.
+type HullAlgorithm uint8
+
+// HullAlgorithm constants define the available convex hull algorithms.
+const (
+	// MonotoneChain selects Andrew's monotone chain algorithm, which sorts the points by coordinate
+	// and builds the lower and upper halves of the hull separately.
+	MonotoneChain HullAlgorithm = iota
+
+	// GrahamScan selects the Graham scan algorithm, which sorts the points by angle around the
+	// lowest point and builds the hull in a single pass.
+	GrahamScan
+)
+
+// String returns a human-readable string representation of the hull algorithm.
+//
+// Returns:
+//   - string: The name of the algorithm: "MonotoneChain" or "GrahamScan".
+//
+// Panics:
+//   - If the HullAlgorithm value is not one of the defined constants.
+func (a HullAlgorithm) String() string {
+	switch a {
+	case MonotoneChain:
+		return "MonotoneChain"
+	case GrahamScan:
+		return "GrahamScan"
+	default:
+		panic(fmt.Errorf("unsupported hull algorithm: %d", a))
+	}
+}
+
+// ConcaveHullKNN computes a concave hull of a set of points, using the k-nearest neighbours algorithm.
+//
+// A concave hull is a simple polygon containing every point in the set that, unlike the [ConvexHull],
+// may follow concavities in the shape of the points. The algorithm is that of Moreira and Santos,
+// "Concave Hull: A k-nearest neighbours approach for the computation of the region occupied by a set of points".
+// The concavity is controlled by the number of neighbours k, rather than by an alpha radius as in an alpha shape.
+//
+// Parameters:
+//   - points ([]Point): The set of points. The slice is not modified.
+//   - k (int): The number of nearest neighbours considered at each step. Smaller values follow the points
+//     more tightly; larger values give a smoother hull, tending towards the convex hull.
+//
+// Returns:
+//   - []Point: The vertices of the hull in counterclockwise order, starting from the point with the lowest
+//     Y-coordinate (and the lowest X-coordinate, if there is more than one). The first point is not repeated at the end.
+//   - error: An error if k is less than three, if there are fewer than three distinct points, if all points
+//     are collinear, or if k is too small to form a valid hull of the points.
+//
+// Behavior:
+//   - Starting from the lowest point, the hull is walked counterclockwise. At each step, the next vertex is the
+//     one of the k nearest remaining points that makes the sharpest right turn without the new edge touching
+//     any earlier edge.
+//   - If no such point exists, or the finished hull does not contain every point, k is too small and an
+//     error is returned; the result is never an invalid polygon. Moreira and Santos retry with k increased
+//     by one, and callers wanting that behaviour can do so themselves.
+//   - Duplicate points are only included once. Points lying on the boundary are treated as contained by it,
+//     and need not be vertices of the hull.
+//   - Each step sorts the remaining points by distance and checks up to k candidate edges against the hull,
+//     and the finished hull is checked to contain every point, so the whole takes O(n²·(k + log n)) time for n points.
+func ConcaveHullKNN(points []Point, k int) ([]Point, error) {
+	if k < 3 {
+		return nil, fmt.Errorf("k must be at least 3, got %d", k)
+	}
+
+	// the same distinct, non-collinear points are needed as for the convex hull
+	if _, err := ConvexHull(points, MonotoneChain, false); err != nil {
+		return nil, fmt.Errorf("cannot compute concave hull: %w", err)
+	}
+
+	distinct := slices.Clone(points)
+	slices.SortFunc(distinct, func(a, b Point) int {
+		return cmp.Or(cmp.Compare(a.x, b.x), cmp.Compare(a.y, b.y))
+	})
+	distinct = slices.CompactFunc(distinct, Point.Eq)
+
+	hull, ok := concaveHullAttempt(distinct, k)
+	if !ok {
+		return nil, fmt.Errorf("cannot compute concave hull: k = %d is too small to form a valid hull of all %d points", k, len(distinct))
+	}
+	return hull, nil
+}
+
+// concaveHullAttempt attempts to compute the concave hull of points, which must be distinct and not all
+// collinear, considering the k nearest neighbours at each step. It reports false if no valid hull is found.
+func concaveHullAttempt(points []Point, k int) ([]Point, bool) {
+	firstIndex := 0
+	for i := range points {
+		if compareHullStart(points[i], points[firstIndex]) < 0 {
+			firstIndex = i
+		}
+	}
+	first := points[firstIndex]
+
+	remaining := make([]Point, 0, len(points))
+	remaining = append(remaining, points[:firstIndex]...)
+	remaining = append(remaining, points[firstIndex+1:]...)
+
+	hull := []Point{first}
+	current := first
+	back := New(-1, 0) // the hull begins heading right, away from an imaginary previous point to the left
+
+	for step := 2; ; step++ {
+		if step == 5 {
+			// the first point may now be used to close the hull
+			remaining = append(remaining, first)
+		}
+
+		// the k nearest remaining points, ordered by how far counterclockwise they are from the previous edge,
+		// so that the sharpest right turn comes first
+		candidates := slices.Clone(remaining)
+		slices.SortStableFunc(candidates, func(a, b Point) int {
+			return cmp.Compare(current.DistanceSquaredToPoint(a), current.DistanceSquaredToPoint(b))
+		})
+		candidates = candidates[:min(k, len(candidates))]
+		slices.SortStableFunc(candidates, func(a, b Point) int {
+			return cmp.Compare(counterclockwiseAngle(back, a.Sub(current)), counterclockwiseAngle(back, b.Sub(current)))
+		})
+
+		next, found := Point{}, false
+		for _, c := range candidates {
+			if !concaveHullEdgeTouchesHull(hull, current, c, c == first) {
+				next, found = c, true
+				break
+			}
+		}
+		if !found {
+			return nil, false
+		}
+
+		if next == first {
+			break
+		}
+
+		hull = append(hull, next)
+		remaining = slices.DeleteFunc(remaining, func(p Point) bool { return p == next })
+		back = current.Sub(next)
+		current = next
+	}
+
+	if len(hull) < 3 || signedArea2X(hull) <= 0 {
+		return nil, false
+	}
+	for _, p := range points {
+		if !ringContainsPoint(hull, p) {
+			return nil, false
+		}
+	}
+	return hull, true
+}
+
+// concaveHullEdgeTouchesHull reports whether a new edge from the last point of an open hull to p touches any
+// edge of the hull other than the last one, which it shares an endpoint with. If closing is true, p is the first
+// point of the hull, and the first edge, which also shares that endpoint, is ignored too.
+func concaveHullEdgeTouchesHull(hull []Point, current, p Point, closing bool) bool {
+	end := len(hull) - 2 // the last edge, hull[len-2] to hull[len-1], is adjacent to the new edge
+	start := 0
+	if closing {
+		start = 1
+	}
+	for i := start; i < end; i++ {
+		if segmentsTouch(hull[i], hull[i+1], current, p) {
+			return true
+		}
+	}
+	return false
+}
+
+// counterclockwiseAngle returns the angle, in the range (0, 2π], through which vector from must be
+// rotated counterclockwise to point in the direction of vector to.
+func counterclockwiseAngle(from, to Point) float64 {
+	angle := math.Atan2(from.CrossProduct(to), from.DotProduct(to))
+	if angle <= 0 {
+		angle += 2 * math.Pi
+	}
+	return angle
+}
+
+// ringContainsPoint reports whether p lies inside, or on the boundary of, the polygon formed by a closed ring of points.
+func ringContainsPoint(ring []Point, p Point) bool {
+	inside := false
+	for i, a := range ring {
+		b := ring[(i+1)%len(ring)]
+		if p.IsBetween(a, b) {
+			return true
+		}
+		// count crossings of a ray from p in the positive X-direction
+		if (a.y > p.y) != (b.y > p.y) && p.x < a.x+(p.y-a.y)*(b.x-a.x)/(b.y-a.y) {
+			inside = !inside
+		}
+	}
+	return inside
+}
+
+// segmentsTouch reports whether the line segments a-b and c-d share at least one point.
+func segmentsTouch(a, b, c, d Point) bool {
+	o1, o2 := Orientation(a, b, c), Orientation(a, b, d)
+	o3, o4 := Orientation(c, d, a), Orientation(c, d, b)
+	if o1 != o2 && o3 != o4 && o1 != Collinear && o2 != Collinear && o3 != Collinear && o4 != Collinear {
+		return true
+	}
+	return c.IsBetween(a, b) || d.IsBetween(a, b) || a.IsBetween(c, d) || b.IsBetween(c, d)
+}
+
+// ConvexHull computes the convex hull of a set of points.
+//
+// The convex hull is the smallest convex polygon containing every point in the set. Both algorithms
+// run in O(n log n) time and, for the same input, return the same hull.
+//
+// Parameters:
+//   - points ([]Point): The set of points. The slice is not modified.
+//   - algorithm (HullAlgorithm): The algorithm to use, either [MonotoneChain] or [GrahamScan].
+//   - includeCollinear (bool): Whether points lying on an edge of the hull, between two of its corners,
+//     are included in the result.
+//
+// Returns:
+//   - []Point: The vertices of the hull in counterclockwise order, starting from the point with the lowest
+//     Y-coordinate (and the lowest X-coordinate, if there is more than one). The first point is not repeated at the end.
+//   - error: An error if there are fewer than three distinct points, if all points are collinear, or if
+//     algorithm is not one of the defined constants. In the first two cases the hull would be degenerate,
+//     with no area.
+//
+// Behavior:
+//   - When includeCollinear is false, only the corners of the hull are returned. This is the minimal vertex set.
+//   - When includeCollinear is true, every input point on the boundary of the hull is returned, in order along
+//     its edge. This allows the hull's edges to be reconstructed exactly from the input points.
+//   - Collinearity is determined using [Orientation], so points within the epsilon tolerance of an edge
+//     are treated as lying on it.
+//   - Duplicate points are only included once.
+//
+// See also [MustConvexHull], which panics instead of returning an error.
+func ConvexHull(points []Point, algorithm HullAlgorithm, includeCollinear bool) ([]Point, error) {
+	if algorithm != MonotoneChain && algorithm != GrahamScan {
+		return nil, fmt.Errorf("unsupported hull algorithm: %d", algorithm)
+	}
+
+	sorted := slices.Clone(points)
+	slices.SortFunc(sorted, func(a, b Point) int {
+		return cmp.Or(cmp.Compare(a.x, b.x), cmp.Compare(a.y, b.y))
+	})
+	sorted = slices.CompactFunc(sorted, Point.Eq)
+
+	if len(sorted) < 3 {
+		return nil, fmt.Errorf("at least 3 distinct points are required to compute a convex hull, got %d", len(sorted))
+	}
+	if hullIsDegenerate(sorted) {
+		return nil, fmt.Errorf("cannot compute convex hull: all %d points are collinear", len(sorted))
+	}
+
+	// keep reports whether the turn at the middle of three consecutive hull points should be kept
+	keep := func(p, q, r Point) bool {
+		o := Orientation(p, q, r)
+		return o == Counterclockwise || (includeCollinear && o == Collinear)
+	}
+
+	if algorithm == GrahamScan {
+		return convexHullGrahamScan(sorted, includeCollinear, keep), nil
+	}
+	return convexHullMonotoneChain(sorted, keep), nil
+}
+
+// MinimumAreaBoundingBox computes the oriented bounding box of a set of points: the rectangle of
+// smallest area, at any rotation, that contains every point.
+//
+// Parameters:
+//   - points ([]Point): The set of points. The slice is not modified.
+//
+// Returns:
+//   - []Point: The four corners of the box in counterclockwise order, starting from the corner with the
+//     lowest Y-coordinate (and the lowest X-coordinate, if there is more than one).
+//   - error: An error if the [ConvexHull] of the points cannot be computed, such as when there are fewer
+//     than three distinct points or all points are collinear.
+//
+// Behavior:
+//   - The minimum-area box always has one side along an edge of the convex hull, so the hull is computed
+//     first and each of its edges is tried in turn using rotating calipers. This takes linear time once
+//     the hull is known.
+//   - The corners are computed in floating point and are generally not exact, even for integer inputs.
+//     Where the box is axis-aligned, compare its corners using [Point.Eq].
+func MinimumAreaBoundingBox(points []Point) ([]Point, error) {
+	hull, err := ConvexHull(points, MonotoneChain, false)
+	if err != nil {
+		return nil, fmt.Errorf("cannot compute minimum area bounding box: %w", err)
+	}
+	n := len(hull)
+	at := func(i int) Point { return hull[i%n] }
+
+	var box []Point
+	bestArea := math.Inf(1)
+
+	// right, top and left are the indices of the hull points furthest along, above and behind each edge.
+	// As the edge advances around the hull, each of them only ever moves forward.
+	right, top, left := 0, 0, 0
+	for i := range n {
+		origin := hull[i]
+		edge := at(i + 1).Sub(origin)
+		length := math.Hypot(edge.x, edge.y)
+		u := New(edge.x/length, edge.y/length)
+		v := New(-u.y, u.x)
+		along := func(j int) float64 { return at(j).Sub(origin).DotProduct(u) }
+		above := func(j int) float64 { return at(j).Sub(origin).DotProduct(v) }
+
+		right = max(right, i+1)
+		for along(right+1) > along(right) {
+			right++
+		}
+		top = max(top, right)
+		for above(top+1) > above(top) {
+			top++
+		}
+		left = max(left, top)
+		for along(left+1) < along(left) {
+			left++
+		}
+
+		minU, maxU, maxV := along(left), along(right), above(top)
+		if area := (maxU - minU) * maxV; area < bestArea {
+			bestArea = area
+			corner := func(s, t float64) Point {
+				return New(origin.x+u.x*s+v.x*t, origin.y+u.y*s+v.y*t)
+			}
+			box = []Point{corner(minU, 0), corner(maxU, 0), corner(maxU, maxV), corner(minU, maxV)}
+		}
+	}
+
+	start := 0
+	for i := range box {
+		if compareHullStart(box[i], box[start]) < 0 {
+			start = i
+		}
+	}
+	return append(box[start:], box[:start]...), nil
+}
+
+// MustConvexHull computes the convex hull of a set of points, as for [ConvexHull], but panics if the
+// hull cannot be computed.
+//
+// This is a convenience for callers that have already validated their input, such as in tests or
+// when the points are known to contain at least three that are not collinear.
+//
+// Parameters:
+//   - points ([]Point): The set of points. The slice is not modified.
+//   - algorithm (HullAlgorithm): The algorithm to use, either [MonotoneChain] or [GrahamScan].
+//   - includeCollinear (bool): Whether points lying on an edge of the hull, between two of its corners,
+//     are included in the result.
+//
+// Returns:
+//   - []Point: The vertices of the hull, as for [ConvexHull].
+//
+// Panics:
+//   - If [ConvexHull] returns an error.
+func MustConvexHull(points []Point, algorithm HullAlgorithm, includeCollinear bool) []Point {
+	hull, err := ConvexHull(points, algorithm, includeCollinear)
+	if err != nil {
+		panic(err)
+	}
+	return hull
+}
+
+// convexHullMonotoneChain computes the convex hull of points, which must be distinct, not all collinear,
+// and sorted by x-coordinate then y-coordinate.
+func convexHullMonotoneChain(points []Point, keep func(p, q, r Point) bool) []Point {
+	hull := make([]Point, 0, 2*len(points))
+
+	// lower hull, from left to right
+	for _, p := range points {
+		for len(hull) >= 2 && !keep(hull[len(hull)-2], hull[len(hull)-1], p) {
+			hull = hull[:len(hull)-1]
+		}
+		hull = append(hull, p)
+	}
+
+	// upper hull, from right to left
+	lowerLen := len(hull)
+	for i := len(points) - 2; i >= 0; i-- {
+		p := points[i]
+		for len(hull) > lowerLen && !keep(hull[len(hull)-2], hull[len(hull)-1], p) {
+			hull = hull[:len(hull)-1]
+		}
+		hull = append(hull, p)
+	}
+
+	// the last point is the first point again
+	hull = hull[:len(hull)-1]
+
+	// rotate the hull to begin at the lowest point
+	start := 0
+	for i := range hull {
+		if compareHullStart(hull[i], hull[start]) < 0 {
+			start = i
+		}
+	}
+	return append(hull[start:], hull[:start]...)
+}
+
+// convexHullGrahamScan computes the convex hull of points, which must be distinct and not all collinear.
+func convexHullGrahamScan(points []Point, includeCollinear bool, keep func(p, q, r Point) bool) []Point {
+	pivotIndex := 0
+	for i := range points {
+		if compareHullStart(points[i], points[pivotIndex]) < 0 {
+			pivotIndex = i
+		}
+	}
+	pivot := points[pivotIndex]
+
+	rest := make([]Point, 0, len(points)-1)
+	rest = append(rest, points[:pivotIndex]...)
+	rest = append(rest, points[pivotIndex+1:]...)
+
+	// all points are above or level with the pivot, so their angles lie in [0, π)
+	SortPointsByAngle(rest, pivot)
+
+	// points on the final ray from the pivot are visited nearest-first, but lie on the closing edge of the hull,
+	// so must be visited farthest-first to be kept
+	if includeCollinear {
+		last := rest[len(rest)-1]
+		i := len(rest) - 1
+		for i > 0 && Orientation(pivot, last, rest[i-1]) == Collinear {
+			i--
+		}
+		slices.Reverse(rest[i:])
+	}
+
+	hull := make([]Point, 0, len(points))
+	hull = append(hull, pivot)
+	for _, p := range rest {
+		for len(hull) >= 2 && !keep(hull[len(hull)-2], hull[len(hull)-1], p) {
+			hull = hull[:len(hull)-1]
+		}
+		hull = append(hull, p)
+	}
+
+	return hull
+}
+
+// compareHullStart orders points by y-coordinate then x-coordinate, so that the minimum is the
+// point at which [ConvexHull] begins the hull.
+func compareHullStart(a, b Point) int {
+	return cmp.Or(cmp.Compare(a.y, b.y), cmp.Compare(a.x, b.x))
+}
+
+// hullIsDegenerate reports whether points, which must be sorted by x-coordinate then y-coordinate,
+// and number at least three, are all collinear.
+func hullIsDegenerate(points []Point) bool {
+	first, last := points[0], points[len(points)-1]
+	for _, p := range points[1 : len(points)-1] {
+		if Orientation(first, last, p) != Collinear {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,323 @@
+package point
+
+import (
+	"github.com/mikenye/geom2d"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"math"
+	"slices"
+	"testing"
+)
+
+func TestConcaveHullKNN(t *testing.T) {
+	// a U shape: the points of a 9x9 grid, with a notch cut into the top
+	uShape := make([]Point, 0, 81)
+	for x := 0; x <= 8; x++ {
+		for y := 0; y <= 8; y++ {
+			if x >= 2 && x <= 6 && y >= 2 {
+				continue
+			}
+			uShape = append(uShape, New(float64(x), float64(y)))
+		}
+	}
+
+	tests := map[string]struct {
+		points  []Point
+		k       int
+		maxArea float64
+	}{
+		"square with interior point": {
+			points:  []Point{New(0, 0), New(2, 0), New(2, 2), New(0, 2), New(1, 1)},
+			k:       3,
+			maxArea: 4,
+		},
+		"U shape follows the notch": {
+			points:  uShape,
+			k:       3,
+			maxArea: 64 - 5*6, // most of the 5x6 notch is excluded
+		},
+		"U shape with large k is convex": {
+			points:  uShape,
+			k:       len(uShape),
+			maxArea: 64,
+		},
+		"duplicates": {
+			points:  []Point{New(0, 0), New(3, 0), New(0, 0), New(3, 3), New(0, 3), New(3, 3)},
+			k:       3,
+			maxArea: 9,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			input := slices.Clone(tc.points)
+			hull, err := ConcaveHullKNN(tc.points, tc.k)
+			require.NoError(t, err)
+			assert.Equal(t, input, tc.points, "input should not be modified")
+
+			assert.Greater(t, signedArea2X(hull), 0.0, "hull should be counterclockwise")
+			assert.LessOrEqual(t, PolygonArea(hull), tc.maxArea+geom2d.GetEpsilon())
+			for _, p := range tc.points {
+				assert.True(t, ringContainsPoint(hull, p), "point %s is outside the hull %v", p, hull)
+			}
+
+			// the hull is simple: no two non-adjacent edges touch
+			n := len(hull)
+			for i := 0; i < n; i++ {
+				for j := i + 2; j < n; j++ {
+					if i == 0 && j == n-1 {
+						continue
+					}
+					assert.False(t, segmentsTouch(hull[i], hull[i+1], hull[j], hull[(j+1)%n]),
+						"edges %d and %d of hull %v touch", i, j, hull)
+				}
+			}
+		})
+	}
+}
+
+func TestConcaveHullKNN_Errors(t *testing.T) {
+	// a U shape with arms one point wide: the points of a 7x7 grid, with a notch cut into the top
+	narrowU := make([]Point, 0, 49)
+	for x := 0; x <= 6; x++ {
+		for y := 0; y <= 6; y++ {
+			if x >= 1 && x <= 5 && y >= 2 {
+				continue
+			}
+			narrowU = append(narrowU, New(float64(x), float64(y)))
+		}
+	}
+
+	tests := map[string]struct {
+		points      []Point
+		k           int
+		expectedErr string
+	}{
+		"k too small": {
+			points:      []Point{New(0, 0), New(1, 0), New(0, 1)},
+			k:           2,
+			expectedErr: "k must be at least 3, got 2",
+		},
+		"too few points": {
+			points:      []Point{New(0, 0), New(1, 0)},
+			k:           3,
+			expectedErr: "cannot compute concave hull: at least 3 distinct points are required to compute a convex hull, got 2",
+		},
+		"all collinear": {
+			points:      []Point{New(0, 0), New(1, 1), New(2, 2)},
+			k:           3,
+			expectedErr: "cannot compute concave hull: cannot compute convex hull: all 3 points are collinear",
+		},
+		"k too tight for a narrow U shape": {
+			points:      narrowU,
+			k:           3,
+			expectedErr: "cannot compute concave hull: k = 3 is too small to form a valid hull of all 24 points",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := ConcaveHullKNN(tc.points, tc.k)
+			assert.EqualError(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestConvexHull(t *testing.T) {
+	tests := map[string]struct {
+		points           []Point
+		includeCollinear bool
+		expected         []Point
+	}{
+		"square with interior point": {
+			points:   []Point{New(1, 1), New(2, 2), New(0, 2), New(2, 0), New(0, 0)},
+			expected: []Point{New(0, 0), New(2, 0), New(2, 2), New(0, 2)},
+		},
+		"square with edge midpoints excluded": {
+			points: []Point{
+				New(0, 0), New(0, 1), New(0, 2), New(1, 0), New(1, 1),
+				New(1, 2), New(2, 0), New(2, 1), New(2, 2),
+			},
+			expected: []Point{New(0, 0), New(2, 0), New(2, 2), New(0, 2)},
+		},
+		"square with edge midpoints included": {
+			points: []Point{
+				New(0, 0), New(0, 1), New(0, 2), New(1, 0), New(1, 1),
+				New(1, 2), New(2, 0), New(2, 1), New(2, 2),
+			},
+			includeCollinear: true,
+			expected: []Point{
+				New(0, 0), New(1, 0), New(2, 0), New(2, 1),
+				New(2, 2), New(1, 2), New(0, 2), New(0, 1),
+			},
+		},
+		"triangle with collinear points on every edge included": {
+			points: []Point{
+				New(2, 4), New(4, 0), New(0, 0), New(2, 0),
+				New(1, 2), New(3, 2), New(2, 1),
+			},
+			includeCollinear: true,
+			expected:         []Point{New(0, 0), New(2, 0), New(4, 0), New(3, 2), New(2, 4), New(1, 2)},
+		},
+		"starts from leftmost of the lowest points": {
+			points:   []Point{New(3, 1), New(0, 3), New(1, 1), New(2, 4)},
+			expected: []Point{New(1, 1), New(3, 1), New(2, 4), New(0, 3)},
+		},
+		"duplicates are removed": {
+			points:   []Point{New(0, 0), New(1, 0), New(0, 0), New(0, 1), New(1, 0)},
+			expected: []Point{New(0, 0), New(1, 0), New(0, 1)},
+		},
+	}
+
+	for name, tc := range tests {
+		for _, algorithm := range []HullAlgorithm{MonotoneChain, GrahamScan} {
+			t.Run(name+"/"+algorithm.String(), func(t *testing.T) {
+				input := slices.Clone(tc.points)
+				actual, err := ConvexHull(tc.points, algorithm, tc.includeCollinear)
+				require.NoError(t, err)
+				assert.Equal(t, tc.expected, actual)
+				assert.Equal(t, input, tc.points, "input should not be modified")
+			})
+		}
+	}
+}
+
+func TestConvexHull_AlgorithmsAgree(t *testing.T) {
+	points := make([]Point, 0, 64)
+	for i := 0; i < 64; i++ {
+		// deterministic scatter, including repeated rows and columns
+		points = append(points, New(float64((i*37)%11), float64((i*53)%13)))
+	}
+
+	for _, includeCollinear := range []bool{false, true} {
+		expected := MustConvexHull(points, MonotoneChain, includeCollinear)
+		actual := MustConvexHull(points, GrahamScan, includeCollinear)
+		assert.Equal(t, expected, actual, "includeCollinear: %t", includeCollinear)
+	}
+}
+
+func TestConvexHull_Errors(t *testing.T) {
+	tests := map[string]struct {
+		points      []Point
+		algorithm   HullAlgorithm
+		expectedErr string
+	}{
+		"empty": {
+			points:      []Point{},
+			expectedErr: "at least 3 distinct points are required to compute a convex hull, got 0",
+		},
+		"two points": {
+			points:      []Point{New(1, 1), New(0, 0)},
+			expectedErr: "at least 3 distinct points are required to compute a convex hull, got 2",
+		},
+		"duplicates of two points": {
+			points:      []Point{New(1, 1), New(0, 0), New(1, 1), New(0, 0)},
+			expectedErr: "at least 3 distinct points are required to compute a convex hull, got 2",
+		},
+		"all collinear": {
+			points:      []Point{New(2, 2), New(0, 4), New(1, 3), New(4, 0)},
+			expectedErr: "cannot compute convex hull: all 4 points are collinear",
+		},
+		"unsupported algorithm": {
+			points:      []Point{New(0, 0), New(1, 0), New(0, 1)},
+			algorithm:   HullAlgorithm(255),
+			expectedErr: "unsupported hull algorithm: 255",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			for _, includeCollinear := range []bool{false, true} {
+				_, err := ConvexHull(tc.points, tc.algorithm, includeCollinear)
+				assert.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestMinimumAreaBoundingBox(t *testing.T) {
+	// a 4x2 rectangle rotated by 30 degrees, filled with points
+	rotated := make([]Point, 0, 15)
+	for x := 0; x <= 4; x++ {
+		for y := 0; y <= 2; y++ {
+			rotated = append(rotated, New(float64(x), float64(y)).Rotate(New(1, 1), math.Pi/6))
+		}
+	}
+
+	tests := map[string]struct {
+		points       []Point
+		expected     []Point // optional; when nil, only the area and containment are checked
+		expectedArea float64
+	}{
+		"axis-aligned square with interior point": {
+			points:       []Point{New(2, 2), New(0, 0), New(1, 1), New(2, 0), New(0, 2)},
+			expected:     []Point{New(0, 0), New(2, 0), New(2, 2), New(0, 2)},
+			expectedArea: 4,
+		},
+		"diamond is its own bounding box": {
+			points:       []Point{New(1, 0), New(0, 1), New(-1, 0), New(0, -1)},
+			expected:     []Point{New(0, -1), New(1, 0), New(0, 1), New(-1, 0)},
+			expectedArea: 2,
+		},
+		"rotated rectangle": {
+			points:       rotated,
+			expectedArea: 8,
+		},
+		"right triangle": {
+			points:       []Point{New(0, 0), New(4, 0), New(0, 3)},
+			expectedArea: 12,
+		},
+		"pentagon is smaller than its axis-aligned bounding box": {
+			points:       []Point{New(0, 0), New(3, 1), New(4, 4), New(1, 5), New(-1, 2)},
+			expectedArea: 20.8,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			input := slices.Clone(tc.points)
+			box, err := MinimumAreaBoundingBox(tc.points)
+			require.NoError(t, err)
+			require.Len(t, box, 4)
+			assert.Equal(t, input, tc.points, "input must not be modified")
+
+			if tc.expected != nil {
+				for i := range tc.expected {
+					assert.True(t, tc.expected[i].Eq(box[i]), "expected %v, got %v", tc.expected, box)
+				}
+			}
+			assert.InDelta(t, tc.expectedArea, signedArea2X(box)/2, geom2d.GetEpsilon())
+			for i := range box {
+				assert.InDelta(t, 0, box[i].Sub(box[(i+1)%4]).DotProduct(box[(i+2)%4].Sub(box[(i+1)%4])), geom2d.GetEpsilon(), "expected right angles")
+				for _, p := range tc.points {
+					assert.NotEqual(t, Clockwise, Orientation(box[i], box[(i+1)%4], p), "expected %v within %v", p, box)
+				}
+			}
+		})
+	}
+}
+
+func TestMinimumAreaBoundingBox_Errors(t *testing.T) {
+	_, err := MinimumAreaBoundingBox([]Point{New(0, 0), New(1, 1), New(2, 2)})
+	assert.EqualError(t, err, "cannot compute minimum area bounding box: cannot compute convex hull: all 3 points are collinear")
+
+	_, err = MinimumAreaBoundingBox([]Point{New(0, 0), New(1, 1)})
+	assert.EqualError(t, err, "cannot compute minimum area bounding box: at least 3 distinct points are required to compute a convex hull, got 2")
+}
+
+func TestMustConvexHull(t *testing.T) {
+	points := []Point{New(0, 0), New(1, 0), New(0, 1), New(0.25, 0.25)}
+	assert.Equal(t, []Point{New(0, 0), New(1, 0), New(0, 1)}, MustConvexHull(points, GrahamScan, false))
+
+	assert.Panics(t, func() {
+		MustConvexHull([]Point{New(0, 0), New(1, 1)}, MonotoneChain, false)
+	})
+}
+
+func TestHullAlgorithm_String(t *testing.T) {
+	assert.Equal(t, "MonotoneChain", MonotoneChain.String())
+	assert.Equal(t, "GrahamScan", GrahamScan.String())
+	assert.Panics(t, func() {
+		_ = HullAlgorithm(255).String()
+	})
+}
@@ -0,0 +1,103 @@
+package point
+
+import (
+	"fmt"
+	"github.com/mikenye/geom2d"
+	"math"
+	"slices"
+)
+
+// MinkowskiSum computes the Minkowski sum of two convex polygons.
+//
+// The Minkowski sum of a and b is the set of all points p+q, where p is in a and q is in b. It is commonly used
+// in motion planning and collision detection: for example, summing an obstacle with the reflection of a robot's
+// shape gives the region the robot's reference point must avoid, and summing a polygon with a small square
+// inflates it by the size of the square.
+//
+// Parameters:
+//   - a ([]Point): The vertices of the first convex polygon, in order. The first point should not be repeated at the end.
+//   - b ([]Point): The vertices of the second convex polygon, in order. The first point should not be repeated at the end.
+//
+// Returns:
+//   - []Point: The vertices of the sum in counterclockwise order, starting from the vertex with the lowest
+//     Y-coordinate (and the lowest X-coordinate, if there is more than one), as for [ConvexHull].
+//     The first point is not repeated at the end.
+//   - error: An error if either polygon has fewer than three points, has zero area or is not convex.
+//
+// Behavior:
+//   - The edges of both polygons are merged in order of angle, which takes O(n+m) time for polygons with n and m vertices.
+//   - The winding direction (clockwise or counterclockwise) of a and b does not matter.
+//   - Where an edge of a is parallel to an edge of b, they are combined into a single edge of the sum.
+//     Collinear vertices in the inputs are not removed, and may appear in the result;
+//     use [RemoveCollinearPoints] to remove them.
+//   - Neither input slice is modified.
+func MinkowskiSum(a, b []Point) ([]Point, error) {
+	p, err := minkowskiSumPrepare(a, "a")
+	if err != nil {
+		return nil, err
+	}
+	q, err := minkowskiSumPrepare(b, "b")
+	if err != nil {
+		return nil, err
+	}
+	n, m := len(p), len(q)
+
+	// repeat the first two vertices, so that edges can be taken across the end of each ring
+	p = append(p, p[0], p[1])
+	q = append(q, q[0], q[1])
+
+	result := make([]Point, 0, n+m)
+	i, j := 0, 0
+	for i < n || j < m {
+		result = append(result, p[i].Add(q[j]))
+		cross := p[i+1].Sub(p[i]).CrossProduct(q[j+1].Sub(q[j]))
+		if cross >= 0 && i < n {
+			i++
+		}
+		if cross <= 0 && j < m {
+			j++
+		}
+	}
+
+	return result, nil
+}
+
+// minkowskiSumPrepare validates a convex polygon for [MinkowskiSum], returning a copy of its vertices in
+// counterclockwise order, starting from its lowest vertex. The name is used in error messages.
+func minkowskiSumPrepare(polygon []Point, name string) ([]Point, error) {
+	if len(polygon) < 3 {
+		return nil, fmt.Errorf("polygon %s must have at least 3 points, got %d", name, len(polygon))
+	}
+
+	ring := slices.Clone(polygon)
+	area := signedArea2X(ring)
+	if area == 0 {
+		return nil, fmt.Errorf("polygon %s has zero area", name)
+	}
+	if area < 0 {
+		slices.Reverse(ring)
+	}
+
+	// every turn must be to the left, and together they must go around only once:
+	// a star polygon such as a pentagram turns left at every vertex, but winds around twice
+	turning := 0.0
+	for i := range ring {
+		p, q, r := ring[i], ring[(i+1)%len(ring)], ring[(i+2)%len(ring)]
+		if Orientation(p, q, r) == Clockwise {
+			return nil, fmt.Errorf("polygon %s is not convex", name)
+		}
+		u, v := q.Sub(p), r.Sub(q)
+		turning += math.Atan2(u.CrossProduct(v), u.DotProduct(v))
+	}
+	if turning > 2*math.Pi+geom2d.GetEpsilon() {
+		return nil, fmt.Errorf("polygon %s is not convex", name)
+	}
+
+	start := 0
+	for i := range ring {
+		if compareHullStart(ring[i], ring[start]) < 0 {
+			start = i
+		}
+	}
+	return append(ring[start:], ring[:start]...), nil
+}
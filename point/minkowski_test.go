@@ -0,0 +1,95 @@
+package point
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestMinkowskiSum(t *testing.T) {
+	tests := map[string]struct {
+		a, b     []Point
+		expected []Point
+	}{
+		"two squares": {
+			a:        []Point{New(0, 0), New(1, 0), New(1, 1), New(0, 1)},
+			b:        []Point{New(0, 0), New(2, 0), New(2, 2), New(0, 2)},
+			expected: []Point{New(0, 0), New(3, 0), New(3, 3), New(0, 3)},
+		},
+		"offset squares in clockwise order": {
+			a:        []Point{New(1, 1), New(1, 2), New(2, 2), New(2, 1)},
+			b:        []Point{New(-1, 2), New(1, 2), New(1, 0), New(-1, 0)},
+			expected: []Point{New(0, 1), New(3, 1), New(3, 4), New(0, 4)},
+		},
+		"triangle and square": {
+			a:        []Point{New(0, 0), New(2, 0), New(1, 2)},
+			b:        []Point{New(0, 0), New(1, 0), New(1, 1), New(0, 1)},
+			expected: []Point{New(0, 0), New(3, 0), New(3, 1), New(2, 3), New(1, 3), New(0, 1)},
+		},
+		"triangle and its reflection": {
+			a:        []Point{New(0, 0), New(1, 0), New(0, 1)},
+			b:        []Point{New(0, 0), New(-1, 0), New(0, -1)},
+			expected: []Point{New(0, -1), New(1, -1), New(1, 0), New(0, 1), New(-1, 1), New(-1, 0)},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			actual, err := MinkowskiSum(tc.a, tc.b)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, actual)
+
+			// the Minkowski sum is commutative
+			actual, err = MinkowskiSum(tc.b, tc.a)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestMinkowskiSum_Errors(t *testing.T) {
+	square := []Point{New(0, 0), New(1, 0), New(1, 1), New(0, 1)}
+
+	// every second vertex of a regular pentagon, so that each turn is to the left
+	pentagon, err := RegularPolygon(Origin(), 1, 5, 0)
+	require.NoError(t, err)
+	pentagram := []Point{pentagon[0], pentagon[2], pentagon[4], pentagon[1], pentagon[3]}
+
+	tests := map[string]struct {
+		a, b        []Point
+		expectedErr string
+	}{
+		"too few points in a": {
+			a:           []Point{New(0, 0), New(1, 0)},
+			b:           square,
+			expectedErr: "polygon a must have at least 3 points, got 2",
+		},
+		"too few points in b": {
+			a:           square,
+			b:           nil,
+			expectedErr: "polygon b must have at least 3 points, got 0",
+		},
+		"non-convex": {
+			a:           square,
+			b:           []Point{New(0, 0), New(2, 0), New(1, 1), New(2, 2), New(0, 2)},
+			expectedErr: "polygon b is not convex",
+		},
+		"collinear": {
+			a:           []Point{New(0, 0), New(1, 0), New(2, 0)},
+			b:           square,
+			expectedErr: "polygon a has zero area",
+		},
+		"pentagram turns left at every vertex but winds twice": {
+			a:           square,
+			b:           pentagram,
+			expectedErr: "polygon b is not convex",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := MinkowskiSum(tc.a, tc.b)
+			assert.EqualError(t, err, tc.expectedErr)
+		})
+	}
+}
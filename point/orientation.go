@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"github.com/mikenye/geom2d"
 	"math"
+	"math/big"
 )
 
 // OrientationType represents the orientation relationship between three points in a 2D plane.
@@ -72,21 +73,190 @@ func (o OrientationType) String() string {
 //   - Positive → Counterclockwise
 //   - Negative → Clockwise
 //   - Near zero (within epsilon) → Collinear
+//   - The cross product is computed with the same filtered predicate as [OrientationExact]: when the
+//     floating-point result is too close to zero for its sign to be trusted, it is recomputed exactly.
+//     Points that are not collinear to within epsilon therefore never get the wrong orientation, even with
+//     large coordinates, while the cost of the usual case is unchanged.
 //
 // Note:
 //   - This is a fundamental operation for many computational geometry algorithms including
 //     convex hull construction, polygon triangulation, and line segment intersection detection.
+//   - For a result with no epsilon, where only exactly collinear points are [Collinear], use [OrientationExact].
 func Orientation(p, q, r Point) OrientationType {
-	val := (q.Sub(p)).CrossProduct(r.Sub(p))
+	det, errBound := orientationDeterminant(p, q, r)
 
 	// Compute adaptive epsilon based on segment lengths
 	epsilon := geom2d.GetEpsilon() * (p.DistanceToPoint(q) + p.DistanceToPoint(r))
 
-	if math.Abs(val) < epsilon {
+	if math.Abs(det) <= errBound {
+		// the sign of det cannot be trusted, so use the exact cross product instead
+		if exact, ok := orientationExactDeterminant(p, q, r); ok {
+			if magnitude, _ := new(big.Rat).Abs(exact).Float64(); magnitude < epsilon {
+				return Collinear
+			}
+			return orientationFromSign(float64(exact.Sign()))
+		}
+	}
+
+	if math.Abs(det) < epsilon {
 		return Collinear // Collinear
 	}
-	if val > 0 {
+	if det > 0 {
 		return Counterclockwise // Counterclockwise
 	}
 	return Clockwise // Clockwise
 }
+
+// orientationErrBound is the relative error bound for the floating-point orientation determinant,
+// as derived in Shewchuk's "Adaptive Precision Floating-Point Arithmetic and Fast Robust Geometric Predicates".
+// If the magnitude of the computed determinant exceeds this fraction of the sum of the magnitudes of its terms,
+// its sign is guaranteed to be correct.
+var orientationErrBound = (3 + 16*math.Pow(2, -53)) * math.Pow(2, -53)
+
+// OrientationExact determines the relative orientation of three points in a 2D plane, using exact arithmetic.
+//
+// Unlike [Orientation], this function does not use an epsilon: the sign of the cross product of the
+// vectors (q-p) and (r-p) is always determined correctly for the given float64 coordinates.
+//
+// Parameters:
+//   - p, q, r (Point): The three points to determine orientation for
+//
+// Returns:
+//   - OrientationType: The orientation relationship:
+//   - Collinear: The points lie exactly on a straight line
+//   - Clockwise: The points make a clockwise turn
+//   - Counterclockwise: The points make a counterclockwise turn
+//
+// Behavior:
+//   - The cross product is first computed in floating-point, and its sign is returned immediately if it is
+//     larger than a guaranteed error bound. This fast path handles the vast majority of inputs.
+//   - For nearly-degenerate configurations, the cross product is recomputed exactly using [big.Rat].
+//
+// Note:
+//   - Use this function when the points are already exact (such as integer or snapped coordinates) and
+//     nearly-collinear configurations must never be misclassified. Use [Orientation] when points that
+//     are collinear to within epsilon should be treated as collinear.
+//   - Coordinates must be finite. If any coordinate is infinite or NaN, the floating-point result is used.
+func OrientationExact(p, q, r Point) OrientationType {
+	det, errBound := orientationDeterminant(p, q, r)
+	if det > errBound {
+		return Counterclockwise
+	}
+	if -det > errBound {
+		return Clockwise
+	}
+
+	exact, ok := orientationExactDeterminant(p, q, r)
+	if !ok {
+		// non-finite input, fall back to the floating-point result
+		return orientationFromSign(det)
+	}
+	return orientationFromSign(float64(exact.Sign()))
+}
+
+// orientationDeterminant returns the cross product of (q-p) and (r-p), computed in floating-point, along with
+// a bound on its error: if the magnitude of the cross product exceeds the bound, its sign is correct.
+func orientationDeterminant(p, q, r Point) (det, errBound float64) {
+	detLeft := (q.x - p.x) * (r.y - p.y)
+	detRight := (q.y - p.y) * (r.x - p.x)
+	return detLeft - detRight, orientationErrBound * (math.Abs(detLeft) + math.Abs(detRight))
+}
+
+// orientationFromSign maps the sign of a cross product to an OrientationType.
+func orientationFromSign(val float64) OrientationType {
+	switch {
+	case val > 0:
+		return Counterclockwise
+	case val < 0:
+		return Clockwise
+	default:
+		return Collinear
+	}
+}
+
+// orientationExactDeterminant returns the cross product of (q-p) and (r-p), computed exactly.
+// The boolean result is false if any coordinate is not finite, as it cannot be represented exactly.
+func orientationExactDeterminant(p, q, r Point) (*big.Rat, bool) {
+	coords := [6]float64{p.x, p.y, q.x, q.y, r.x, r.y}
+	var v [6]*big.Rat
+	for i, c := range coords {
+		if math.IsInf(c, 0) || math.IsNaN(c) {
+			return nil, false
+		}
+		v[i] = new(big.Rat).SetFloat64(c)
+	}
+	px, py, qx, qy, rx, ry := v[0], v[1], v[2], v[3], v[4], v[5]
+
+	ax := new(big.Rat).Sub(qx, px)
+	ay := new(big.Rat).Sub(qy, py)
+	bx := new(big.Rat).Sub(rx, px)
+	by := new(big.Rat).Sub(ry, py)
+
+	left := new(big.Rat).Mul(ax, by)
+	right := new(big.Rat).Mul(ay, bx)
+	return left.Sub(left, right), true
+}
+
+// IsCollinear reports whether three points lie on a single straight line.
+//
+// This is a convenience wrapper around [Orientation], and uses the same adaptive epsilon
+// when testing the magnitude of the cross product.
+//
+// Parameters:
+//   - a, b, c (Point): The three points to test.
+//
+// Returns:
+//   - bool: true if [Orientation] of a, b and c is [Collinear], false otherwise.
+func IsCollinear(a, b, c Point) bool {
+	return Orientation(a, b, c) == Collinear
+}
+
+// RemoveCollinearPoints removes redundant vertices from a closed ring of points.
+//
+// A vertex is redundant if it is collinear with its previous and next neighbors, as determined by
+// [IsCollinear]. Since the ring is treated as closed, the first and last points are also considered
+// neighbors of each other.
+//
+// Parameters:
+//   - points ([]Point): The vertices of the ring, in order. The first point should not be repeated at the end.
+//
+// Returns:
+//   - []Point: A new slice containing the remaining vertices, in their original order.
+//
+// Behavior:
+//   - Consecutive duplicate points and zero-area "spikes" (where the ring doubles back on itself) are also removed,
+//     as these vertices are collinear with their neighbors.
+//   - Removal is repeated until no redundant vertices remain, so runs of collinear vertices are reduced to their endpoints.
+//   - If fewer than three points are given, a copy of the input is returned unchanged.
+//   - The input slice is not modified.
+func RemoveCollinearPoints(points []Point) []Point {
+	if len(points) < 3 {
+		result := make([]Point, len(points))
+		copy(result, points)
+		return result
+	}
+
+	result := make([]Point, 0, len(points))
+	for _, p := range points {
+		result = append(result, p)
+		for len(result) >= 3 && IsCollinear(result[len(result)-3], result[len(result)-2], result[len(result)-1]) {
+			// remove the middle point of the last three
+			result = append(result[:len(result)-2], result[len(result)-1])
+		}
+	}
+
+	// handle the wrap-around between the end and the start of the ring
+	for len(result) >= 3 {
+		n := len(result)
+		switch {
+		case IsCollinear(result[n-2], result[n-1], result[0]):
+			result = result[:n-1]
+		case IsCollinear(result[n-1], result[0], result[1]):
+			result = result[1:]
+		default:
+			return result
+		}
+	}
+
+	return result
+}
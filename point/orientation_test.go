@@ -0,0 +1,221 @@
+package point
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestIsCollinear(t *testing.T) {
+	tests := map[string]struct {
+		a, b, c  Point
+		expected bool
+	}{
+		"collinear, b between a and c": {
+			a:        New(0, 0),
+			b:        New(1, 1),
+			c:        New(2, 2),
+			expected: true,
+		},
+		"collinear, b beyond c": {
+			a:        New(0, 0),
+			b:        New(3, 3),
+			c:        New(2, 2),
+			expected: true,
+		},
+		"counterclockwise": {
+			a:        New(0, 0),
+			b:        New(1, 0),
+			c:        New(1, 1),
+			expected: false,
+		},
+		"clockwise": {
+			a:        New(0, 0),
+			b:        New(1, 1),
+			c:        New(1, 0),
+			expected: false,
+		},
+		"nearly collinear within epsilon": {
+			a:        New(0, 0),
+			b:        New(1, 1e-13),
+			c:        New(2, 0),
+			expected: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, IsCollinear(tc.a, tc.b, tc.c))
+		})
+	}
+}
+
+func TestRemoveCollinearPoints(t *testing.T) {
+	tests := map[string]struct {
+		points   []Point
+		expected []Point
+	}{
+		"nothing to remove": {
+			points:   []Point{New(0, 0), New(4, 0), New(4, 4), New(0, 4)},
+			expected: []Point{New(0, 0), New(4, 0), New(4, 4), New(0, 4)},
+		},
+		"midpoints on each edge": {
+			points: []Point{
+				New(0, 0), New(2, 0), New(4, 0), New(4, 2),
+				New(4, 4), New(2, 4), New(0, 4), New(0, 2),
+			},
+			expected: []Point{New(0, 0), New(4, 0), New(4, 4), New(0, 4)},
+		},
+		"run of collinear points": {
+			points:   []Point{New(0, 0), New(1, 0), New(2, 0), New(3, 0), New(3, 3)},
+			expected: []Point{New(0, 0), New(3, 0), New(3, 3)},
+		},
+		"collinear across the wrap-around": {
+			points:   []Point{New(2, 0), New(4, 0), New(4, 4), New(0, 4), New(0, 0)},
+			expected: []Point{New(4, 0), New(4, 4), New(0, 4), New(0, 0)},
+		},
+		"consecutive duplicate points": {
+			points:   []Point{New(0, 0), New(4, 0), New(4, 0), New(4, 4)},
+			expected: []Point{New(0, 0), New(4, 0), New(4, 4)},
+		},
+		"fewer than three points": {
+			points:   []Point{New(0, 0), New(1, 1)},
+			expected: []Point{New(0, 0), New(1, 1)},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			original := make([]Point, len(tc.points))
+			copy(original, tc.points)
+
+			actual := RemoveCollinearPoints(tc.points)
+			assert.Equal(t, tc.expected, actual)
+			assert.Equal(t, original, tc.points, "input should not be modified")
+		})
+	}
+}
+
+func TestOrientation(t *testing.T) {
+	tests := map[string]struct {
+		p, q, r  Point
+		expected OrientationType
+	}{
+		"counterclockwise": {
+			p:        New(0, 0),
+			q:        New(1, 0),
+			r:        New(1, 1),
+			expected: Counterclockwise,
+		},
+		"clockwise": {
+			p:        New(0, 0),
+			q:        New(1, 1),
+			r:        New(1, 0),
+			expected: Clockwise,
+		},
+		"collinear": {
+			p:        New(0, 0),
+			q:        New(1, 1),
+			r:        New(2, 2),
+			expected: Collinear,
+		},
+		"nearly collinear within epsilon": {
+			p:        New(0, 0),
+			q:        New(1, 1e-13),
+			r:        New(2, 0),
+			expected: Collinear,
+		},
+		"large coordinates, where floating-point gets the sign wrong": {
+			p:        New(32768+41*0x1p-37, 32768+48*0x1p-37),
+			q:        New(786432, 786432),
+			r:        New(1572864, 1572864),
+			expected: Counterclockwise,
+		},
+		"large coordinates, where floating-point error exceeds epsilon": {
+			p:        New(2048+41*0x1p-41, 2048+48*0x1p-41),
+			q:        New(49152, 49152),
+			r:        New(98304, 98304),
+			expected: Collinear,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, Orientation(tc.p, tc.q, tc.r))
+		})
+	}
+}
+
+func TestOrientationExact(t *testing.T) {
+	tests := map[string]struct {
+		p, q, r  Point
+		expected OrientationType
+	}{
+		"counterclockwise": {
+			p:        New(0, 0),
+			q:        New(1, 0),
+			r:        New(1, 1),
+			expected: Counterclockwise,
+		},
+		"clockwise": {
+			p:        New(0, 0),
+			q:        New(1, 1),
+			r:        New(1, 0),
+			expected: Clockwise,
+		},
+		"exactly collinear": {
+			p:        New(0.5, 0.5),
+			q:        New(12, 12),
+			r:        New(24, 24),
+			expected: Collinear,
+		},
+		"one ulp off the line is not collinear": {
+			p:        New(0.5, 0.5+0x1p-53),
+			q:        New(12, 12),
+			r:        New(24, 24),
+			expected: Counterclockwise,
+		},
+		"nearly collinear, where floating-point gets the sign wrong": {
+			p:        New(0.5+41*0x1p-53, 0.5+48*0x1p-53),
+			q:        New(12, 12),
+			r:        New(24, 24),
+			expected: Counterclockwise,
+		},
+		"nearly collinear within epsilon is still classified": {
+			p:        New(0, 0),
+			q:        New(1, 1e-13),
+			r:        New(2, 0),
+			expected: Clockwise,
+		},
+		"large collinear integer coordinates": {
+			p:        New(-9007199254740991, -9007199254740991),
+			q:        New(3, 3),
+			r:        New(9007199254740991, 9007199254740991),
+			expected: Collinear,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, OrientationExact(tc.p, tc.q, tc.r))
+		})
+	}
+}
+
+func TestOrientationExact_MatchesExactArithmetic(t *testing.T) {
+	// perturb p by a grid of ulps around a nearly-degenerate configuration
+	q, r := New(12, 12), New(24, 24)
+	for i := 0; i < 32; i++ {
+		for j := 0; j < 32; j++ {
+			p := New(0.5+float64(i)*0x1p-53, 0.5+float64(j)*0x1p-53)
+
+			// p lies on the line y = x exactly when i == j, and is above it (counterclockwise) when j > i
+			expected := Collinear
+			if j > i {
+				expected = Counterclockwise
+			} else if j < i {
+				expected = Clockwise
+			}
+			assert.Equal(t, expected, OrientationExact(p, q, r), "p=(%v,%v)", p.x, p.y)
+		}
+	}
+}
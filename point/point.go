@@ -11,15 +11,19 @@
 // # Key Features
 //
 // Creation & Type Conversion
-//   - Points can be created using New and NewFromImagePoint.
-//   - Conversion methods (AsFloat32, AsFloat64, AsInt, AsIntRounded) allow working with different numeric types.
+//   - Points can be created using New, NewFromImagePoint and NewFromPolar.
+//   - ToPolar converts a point to polar coordinates relative to an origin.
+//   - Coordinates are always float64. NewFromImagePoint converts from the integer coordinates of an [image.Point].
 //
 // Vector Operations
 //   - Basic operations like Translate and Negate enable geometric transformations.
 //   - Scale allows uniform scaling around a reference point.
+//   - SnapToGrid rounds a point to the nearest intersection of a square grid.
 //
 // Distance & Angle Measurements
 //   - DistanceToPoint and DistanceSquaredToPoint provide Euclidean distance calculations.
+//   - DistanceToPointManhattan and DistanceToPointChebyshev provide grid-based distance calculations.
+//   - DistanceToPointHaversine provides great-circle distances for longitude/latitude coordinates.
 //   - AngleBetween and CosineOfAngleBetween help determine angular relationships between points.
 //   - CrossProduct and DotProduct support vector orientation and projection calculations.
 //
@@ -108,6 +112,25 @@ func NewFromImagePoint(q image.Point) Point {
 	}
 }
 
+// NewFromPolar creates a new Point from polar coordinates relative to an origin point.
+//
+// This is the inverse of [Point.ToPolar].
+//
+// Parameters:
+//   - origin (Point): The pole of the polar coordinate system.
+//   - r (float64): The distance from the origin.
+//   - theta (float64): The angle in radians, measured counterclockwise from the positive X-axis,
+//     consistent with [Point.Rotate]. Any angle is accepted.
+//
+// Returns:
+//   - Point: The point at distance r from the origin, in the direction theta.
+func NewFromPolar(origin Point, r, theta float64) Point {
+	return New(
+		origin.x+r*math.Cos(theta),
+		origin.y+r*math.Sin(theta),
+	)
+}
+
 // Add returns the sum of two points as if they were vectors.
 // It performs component-wise addition:
 //
@@ -278,6 +301,64 @@ func (p Point) DistanceToPoint(q Point) float64 {
 	return math.Sqrt(p.DistanceSquaredToPoint(q))
 }
 
+// DistanceToPointChebyshev calculates the [Chebyshev distance] between Point p and another Point q.
+// This is the greatest of the absolute differences between the x and y coordinates, and corresponds
+// to the number of moves a king needs to travel between two squares on a chessboard.
+//
+// Parameters:
+//   - q (Point): The Point to which the distance is calculated.
+//
+// Returns:
+//   - float64: The Chebyshev distance, max(|p.x - q.x|, |p.y - q.y|).
+//
+// [Chebyshev distance]: https://en.wikipedia.org/wiki/Chebyshev_distance
+func (p Point) DistanceToPointChebyshev(q Point) float64 {
+	return math.Max(math.Abs(p.x-q.x), math.Abs(p.y-q.y))
+}
+
+// DistanceToPointHaversine calculates the great-circle distance between Point p and another Point q
+// on the surface of a sphere, using the [haversine formula].
+//
+// Unlike the other distance methods, this interprets the coordinates geographically rather than on a plane:
+// X is the longitude and Y is the latitude, both in degrees. This makes it suitable for latitude/longitude map data.
+//
+// Parameters:
+//   - q (Point): The Point to which the distance is calculated, as (longitude, latitude) in degrees.
+//   - radius (float64): The radius of the sphere. The result is in the same units, so for example
+//     pass 6371 (the mean radius of the Earth in kilometers) to obtain a distance in kilometers.
+//
+// Returns:
+//   - float64: The shortest distance between p and q along the surface of the sphere.
+//
+// [haversine formula]: https://en.wikipedia.org/wiki/Haversine_formula
+func (p Point) DistanceToPointHaversine(q Point, radius float64) float64 {
+	lat1 := p.y * math.Pi / 180
+	lat2 := q.y * math.Pi / 180
+	dLat := lat2 - lat1
+	dLon := (q.x - p.x) * math.Pi / 180
+
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLon/2)*math.Sin(dLon/2)
+
+	// clamp to guard against rounding just above 1 for antipodal points
+	return 2 * radius * math.Asin(math.Sqrt(min(h, 1)))
+}
+
+// DistanceToPointManhattan calculates the [Manhattan distance] between Point p and another Point q.
+// This is the sum of the absolute differences between the x and y coordinates, and is commonly used
+// for grid-based pathfinding where only horizontal and vertical moves are allowed.
+//
+// Parameters:
+//   - q (Point): The Point to which the distance is calculated.
+//
+// Returns:
+//   - float64: The Manhattan distance, |p.x - q.x| + |p.y - q.y|.
+//
+// [Manhattan distance]: https://en.wikipedia.org/wiki/Taxicab_geometry
+func (p Point) DistanceToPointManhattan(q Point) float64 {
+	return math.Abs(p.x-q.x) + math.Abs(p.y-q.y)
+}
+
 // DotProduct calculates the dot product of the vector represented by Point origin with the vector represented by Point q.
 // The dot product is defined as origin.x*q.x + origin.y*q.y and is widely used in geometry for angle calculations,
 // projection operations, and determining the relationship between two vectors.
@@ -311,6 +392,46 @@ func (p Point) Eq(q Point) bool {
 	return numeric.FloatEquals(p.x, q.x, geom2d.GetEpsilon()) && numeric.FloatEquals(p.y, q.y, geom2d.GetEpsilon())
 }
 
+// IsBetween reports whether Point p lies on the line segment between points a and b.
+//
+// Parameters:
+//   - a, b (Point): The endpoints of the line segment, in either order.
+//
+// Returns:
+//   - bool: true if p is collinear with a and b and lies between them, false otherwise.
+//
+// Behavior:
+//   - Collinearity is determined using [IsCollinear], so points within the epsilon tolerance of the line
+//     through a and b are treated as lying on it.
+//   - p lies between a and b if its projection onto the line through a and b falls within the bounds of the segment.
+//   - The endpoints are included, and p is considered to be at an endpoint if it is equal to it according to [Point.Eq].
+//   - If a and b are equal, p is between them only if it is equal to a.
+func (p Point) IsBetween(a, b Point) bool {
+	if p.Eq(a) || p.Eq(b) {
+		return true
+	}
+	if a.Eq(b) || !IsCollinear(a, b, p) {
+		return false
+	}
+	ab := b.Sub(a)
+	t := p.Sub(a).DotProduct(ab) / ab.DotProduct(ab)
+	return t >= 0 && t <= 1
+}
+
+// Map applies a coordinate transformation function to the Point.
+//
+// Map is provided for consistency with the Map methods of the other geometric types, so that generic
+// pipelines can transform any shape in the same way.
+//
+// Parameters:
+//   - fn (func(Point) Point): The transformation to apply.
+//
+// Returns:
+//   - Point: The result of fn(p).
+func (p Point) Map(fn func(Point) Point) Point {
+	return fn(p)
+}
+
 // MarshalJSON serializes Point as JSON.
 func (p Point) MarshalJSON() ([]byte, error) {
 	return json.Marshal(struct {
@@ -337,6 +458,21 @@ func (p Point) Negate() Point {
 	return New(-p.x, -p.y)
 }
 
+// ReflectAcrossPoint reflects the point through a given center point (a point reflection,
+// equivalent to a 180° rotation about center).
+//
+// Parameters:
+//   - center (Point): The point through which the reflection is performed.
+//
+// Returns:
+//   - Point: A new Point such that center is the midpoint between the original point and the result.
+//
+// Notes:
+//   - To reflect a point across a line, use the ReflectPoint method of linesegment.LineSegment.
+func (p Point) ReflectAcrossPoint(center Point) Point {
+	return New(2*center.x-p.x, 2*center.y-p.y)
+}
+
 // RelationshipToPoint determines the spatial relationship between the current Point and another Point.
 //
 // Relationships:
@@ -392,6 +528,34 @@ func (p Point) Rotate(pivot Point, radians float64) Point {
 	return New(newX, newY)
 }
 
+// RotateOrthogonal rotates the point counter-clockwise around a pivot point by a whole number of quarter turns (90°).
+//
+// Unlike [Point.Rotate], this method does not use trigonometric functions, so coordinates are
+// swapped and negated exactly with no floating-point error. Integer coordinates remain integers.
+//
+// Parameters:
+//   - pivot (Point): The point around which the rotation is performed.
+//   - quarterTurns (int): The number of 90° counter-clockwise turns. Negative values rotate
+//     clockwise, and values outside [0, 3] are normalized modulo 4.
+//
+// Returns:
+//   - Point: A new point representing the rotated position.
+func (p Point) RotateOrthogonal(pivot Point, quarterTurns int) Point {
+	dx := p.x - pivot.x
+	dy := p.y - pivot.y
+
+	switch ((quarterTurns % 4) + 4) % 4 {
+	case 1:
+		return New(pivot.x-dy, pivot.y+dx)
+	case 2:
+		return New(pivot.x-dx, pivot.y-dy)
+	case 3:
+		return New(pivot.x+dy, pivot.y-dx)
+	default:
+		return p
+	}
+}
+
 // Scale scales the point by a factor k relative to a reference point ref.
 //
 // Parameters:
@@ -407,6 +571,45 @@ func (p Point) Scale(ref Point, k float64) Point {
 	)
 }
 
+// SnapToGrid rounds the point to the nearest intersection of a square grid.
+//
+// This is useful for cleaning up noisy floating-point coordinates, for example when collapsing
+// near-duplicate vertices from geometry imported from imprecise sources.
+//
+// Parameters:
+//   - origin (Point): A point lying on a grid intersection, which anchors the grid.
+//   - cellSize (float64): The spacing between adjacent grid lines.
+//
+// Returns:
+//   - Point: The grid intersection nearest to p.
+//
+// Behavior:
+//   - Each coordinate is rounded independently using [math.RoundToEven], so points exactly halfway between
+//     two grid lines are snapped to the even grid line, avoiding a systematic bias in one direction.
+//   - If cellSize is not positive, p is returned unchanged.
+func (p Point) SnapToGrid(origin Point, cellSize float64) Point {
+	if !(cellSize > 0) {
+		return p
+	}
+	return New(
+		origin.x+math.RoundToEven((p.x-origin.x)/cellSize)*cellSize,
+		origin.y+math.RoundToEven((p.y-origin.y)/cellSize)*cellSize,
+	)
+}
+
+// StringWithPrecision returns a string representation of the Point in the format "(x,y)",
+// with each coordinate printed using the given number of decimal places.
+//
+// Parameters:
+//   - decimals (int): The number of digits after the decimal point. If negative, the shortest
+//     representation that exactly represents each coordinate is used.
+//
+// Returns:
+//   - string: A string representation of the Point, such as "(1.50,2.00)".
+func (p Point) StringWithPrecision(decimals int) string {
+	return fmt.Sprintf("(%s,%s)", numeric.FormatFloat(p.x, decimals), numeric.FormatFloat(p.y, decimals))
+}
+
 // String returns a string representation of the Point origin in the format "(x, y)".
 // This provides a readable format for the point’s coordinates, useful for debugging
 // and displaying points in logs or output.
@@ -422,6 +625,30 @@ func (p Point) Sub(q Point) Point {
 	return New(p.x-q.x, p.y-q.y)
 }
 
+// ToPolar converts the point to polar coordinates relative to an origin point.
+//
+// This is the inverse of [NewFromPolar].
+//
+// Parameters:
+//   - origin (Point): The pole of the polar coordinate system.
+//
+// Returns:
+//   - r (float64): The distance from the origin to the point.
+//   - theta (float64): The angle in radians from the origin to the point, measured counterclockwise from the
+//     positive X-axis, consistent with [Point.Rotate]. The angle is in the range (-π, π].
+//
+// Behavior:
+//   - If the point is equal to the origin, both r and theta are 0.
+func (p Point) ToPolar(origin Point) (r float64, theta float64) {
+	dx := p.x - origin.x
+	dy := p.y - origin.y
+	theta = math.Atan2(dy, dx)
+	if theta == -math.Pi {
+		theta = math.Pi // dy is -0, keep within (-π, π]
+	}
+	return math.Hypot(dx, dy), theta
+}
+
 // Translate moves the Point by a given displacement vector.
 //
 // Parameters:
@@ -447,6 +674,26 @@ func (p *Point) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// WithinDistance reports whether Point q lies within a given Euclidean distance of Point p.
+//
+// This is equivalent to comparing [Point.DistanceToPoint] against d, but compares squared distances
+// to avoid computing a square root.
+//
+// Parameters:
+//   - q (Point): The point to test.
+//   - d (float64): The maximum distance.
+//
+// Returns:
+//   - bool: true if the distance between p and q is less than or equal to d, false otherwise.
+//
+// Behavior:
+//   - The boundary is included: a point exactly d away is within the distance.
+//   - The comparison is exact, without epsilon tolerance.
+//   - If d is negative, false is returned.
+func (p Point) WithinDistance(q Point, d float64) bool {
+	return d >= 0 && p.DistanceSquaredToPoint(q) <= d*d
+}
+
 // X returns the x-coordinate of the Point origin.
 // This accessor provides read-only access to the x-coordinate.
 //
@@ -151,6 +151,107 @@ func TestPoint_DistanceToPoint(t *testing.T) {
 	}
 }
 
+func TestPoint_DistanceToPointChebyshevManhattan(t *testing.T) {
+	tests := map[string]struct {
+		p, q              Point
+		expectedChebyshev float64
+		expectedManhattan float64
+	}{
+		"same point": {
+			p:                 New(1, 1),
+			q:                 New(1, 1),
+			expectedChebyshev: 0,
+			expectedManhattan: 0,
+		},
+		"horizontal": {
+			p:                 New(0, 0),
+			q:                 New(5, 0),
+			expectedChebyshev: 5,
+			expectedManhattan: 5,
+		},
+		"diagonal": {
+			p:                 New(1, 2),
+			q:                 New(4, 6),
+			expectedChebyshev: 4,
+			expectedManhattan: 7,
+		},
+		"negative coordinates": {
+			p:                 New(-2, 3),
+			q:                 New(2, -3),
+			expectedChebyshev: 6,
+			expectedManhattan: 10,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expectedChebyshev, tc.p.DistanceToPointChebyshev(tc.q))
+			assert.Equal(t, tc.expectedChebyshev, tc.q.DistanceToPointChebyshev(tc.p))
+			assert.Equal(t, tc.expectedManhattan, tc.p.DistanceToPointManhattan(tc.q))
+			assert.Equal(t, tc.expectedManhattan, tc.q.DistanceToPointManhattan(tc.p))
+		})
+	}
+}
+
+func TestPoint_DistanceToPointHaversine(t *testing.T) {
+	tests := map[string]struct {
+		p, q     Point
+		radius   float64
+		expected float64
+		delta    float64
+	}{
+		"same point": {
+			p:        New(12.5, -33),
+			q:        New(12.5, -33),
+			radius:   1,
+			expected: 0,
+			delta:    geom2d.GetEpsilon(),
+		},
+		"quarter of the equator": {
+			p:        New(0, 0),
+			q:        New(90, 0),
+			radius:   1,
+			expected: math.Pi / 2,
+			delta:    geom2d.GetEpsilon(),
+		},
+		"antipodal points on the equator": {
+			p:        New(0, 0),
+			q:        New(180, 0),
+			radius:   2,
+			expected: 2 * math.Pi,
+			delta:    geom2d.GetEpsilon(),
+		},
+		"pole to pole": {
+			p:        New(0, 90),
+			q:        New(45, -90),
+			radius:   1,
+			expected: math.Pi,
+			delta:    geom2d.GetEpsilon(),
+		},
+		"across the antimeridian": {
+			p:        New(179, 0),
+			q:        New(-179, 0),
+			radius:   1,
+			expected: 2 * math.Pi / 180,
+			delta:    geom2d.GetEpsilon(),
+		},
+		"London to Paris in kilometers": {
+			p:        New(-0.1278, 51.5074),
+			q:        New(2.3522, 48.8566),
+			radius:   6371,
+			expected: 343.5,
+			delta:    0.5,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.InDelta(t, tc.expected, tc.p.DistanceToPointHaversine(tc.q, tc.radius), tc.delta)
+			assert.InDelta(t, tc.expected, tc.q.DistanceToPointHaversine(tc.p, tc.radius), tc.delta)
+		})
+	}
+}
+
 func TestPoint_DotProduct(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -250,6 +351,38 @@ func TestPoint_Rotate(t *testing.T) {
 	}
 }
 
+func TestPoint_IsBetween(t *testing.T) {
+	tests := map[string]struct {
+		p, a, b  Point
+		expected bool
+	}{
+		"midpoint":                   {p: New(1, 1), a: New(0, 0), b: New(2, 2), expected: true},
+		"endpoints in reverse order": {p: New(1, 1), a: New(2, 2), b: New(0, 0), expected: true},
+		"at first endpoint":          {p: New(0, 0), a: New(0, 0), b: New(2, 2), expected: true},
+		"at second endpoint":         {p: New(2, 2), a: New(0, 0), b: New(2, 2), expected: true},
+		"near endpoint within epsilon": {
+			p: New(2+geom2d.GetEpsilon()/2, 2), a: New(0, 0), b: New(2, 2), expected: true,
+		},
+		"collinear beyond second endpoint": {p: New(3, 3), a: New(0, 0), b: New(2, 2), expected: false},
+		"collinear before first endpoint":  {p: New(-1, -1), a: New(0, 0), b: New(2, 2), expected: false},
+		"not collinear":                    {p: New(1, 0), a: New(0, 0), b: New(2, 2), expected: false},
+		"on vertical segment":              {p: New(3, 1.5), a: New(3, 0), b: New(3, 4), expected: true},
+		"degenerate segment, equal":        {p: New(1, 1), a: New(1, 1), b: New(1, 1), expected: true},
+		"degenerate segment, not equal":    {p: New(1, 2), a: New(1, 1), b: New(1, 1), expected: false},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.p.IsBetween(tc.a, tc.b))
+		})
+	}
+}
+
+func TestPoint_Map(t *testing.T) {
+	double := func(p Point) Point { return New(p.x*2, p.y*2) }
+	assert.Equal(t, New(2, -6), New(1, -3).Map(double))
+}
+
 func TestPoint_MarshalUnmarshalJSON(t *testing.T) {
 	tests := map[string]struct {
 		point    Point
@@ -281,6 +414,37 @@ func TestPoint_Negate(t *testing.T) {
 	assert.Equal(t, New(-1, -2), p.Negate())
 }
 
+func TestPoint_ReflectAcrossPoint(t *testing.T) {
+	tests := map[string]struct {
+		point    Point
+		center   Point
+		expected Point
+	}{
+		"reflect across origin": {
+			point:    New(3, 4),
+			center:   New(0, 0),
+			expected: New(-3, -4),
+		},
+		"reflect across offset center": {
+			point:    New(1, 1),
+			center:   New(2, 3),
+			expected: New(3, 5),
+		},
+		"point equal to center": {
+			point:    New(2, 3),
+			center:   New(2, 3),
+			expected: New(2, 3),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			actual := tc.point.ReflectAcrossPoint(tc.center)
+			assert.True(t, tc.expected.Eq(actual), "expected %s, got %s", tc.expected, actual)
+		})
+	}
+}
+
 func TestPoint_RelationshipToPoint(t *testing.T) {
 	tests := map[string]struct {
 		pointA      Point
@@ -307,6 +471,77 @@ func TestPoint_RelationshipToPoint(t *testing.T) {
 	}
 }
 
+func TestPoint_RotateOrthogonal(t *testing.T) {
+	tests := map[string]struct {
+		point        Point
+		pivot        Point
+		quarterTurns int
+		expected     Point
+	}{
+		"zero turns": {
+			point:        New(3, 1),
+			pivot:        New(0, 0),
+			quarterTurns: 0,
+			expected:     New(3, 1),
+		},
+		"one turn around origin": {
+			point:        New(3, 1),
+			pivot:        New(0, 0),
+			quarterTurns: 1,
+			expected:     New(-1, 3),
+		},
+		"two turns around origin": {
+			point:        New(3, 1),
+			pivot:        New(0, 0),
+			quarterTurns: 2,
+			expected:     New(-3, -1),
+		},
+		"three turns around origin": {
+			point:        New(3, 1),
+			pivot:        New(0, 0),
+			quarterTurns: 3,
+			expected:     New(1, -3),
+		},
+		"four turns is identity": {
+			point:        New(3, 1),
+			pivot:        New(0, 0),
+			quarterTurns: 4,
+			expected:     New(3, 1),
+		},
+		"negative turn is clockwise": {
+			point:        New(3, 1),
+			pivot:        New(0, 0),
+			quarterTurns: -1,
+			expected:     New(1, -3),
+		},
+		"negative turns beyond a full rotation": {
+			point:        New(3, 1),
+			pivot:        New(0, 0),
+			quarterTurns: -7,
+			expected:     New(-1, 3),
+		},
+		"one turn around custom pivot": {
+			point:        New(2, 1),
+			pivot:        New(1, 1),
+			quarterTurns: 1,
+			expected:     New(1, 2),
+		},
+		"large integer coordinates are exact": {
+			point:        New(123456789, -987654321),
+			pivot:        New(5, 7),
+			quarterTurns: 1,
+			expected:     New(987654333, 123456791),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			actual := tc.point.RotateOrthogonal(tc.pivot, tc.quarterTurns)
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
 func TestPoint_Scale(t *testing.T) {
 	tests := map[string]struct {
 		point    Point   // Point to be scaled
@@ -338,6 +573,60 @@ func TestPoint_Scale(t *testing.T) {
 	}
 }
 
+func TestPoint_SnapToGrid(t *testing.T) {
+	tests := map[string]struct {
+		point    Point
+		origin   Point
+		cellSize float64
+		expected Point
+	}{
+		"already on grid": {
+			point:    New(2, 4),
+			origin:   New(0, 0),
+			cellSize: 1,
+			expected: New(2, 4),
+		},
+		"noisy coordinates": {
+			point:    New(1.9999999, 3.0000001),
+			origin:   New(0, 0),
+			cellSize: 1,
+			expected: New(2, 3),
+		},
+		"half rounds to even": {
+			point:    New(2.5, 3.5),
+			origin:   New(0, 0),
+			cellSize: 1,
+			expected: New(2, 4),
+		},
+		"negative half rounds to even": {
+			point:    New(-2.5, -1.5),
+			origin:   New(0, 0),
+			cellSize: 1,
+			expected: New(-2, -2),
+		},
+		"offset origin and larger cells": {
+			point:    New(7, 13),
+			origin:   New(1, 1),
+			cellSize: 5,
+			expected: New(6, 11),
+		},
+		"non-positive cell size": {
+			point:    New(1.3, 2.7),
+			origin:   New(0, 0),
+			cellSize: 0,
+			expected: New(1.3, 2.7),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			actual := tc.point.SnapToGrid(tc.origin, tc.cellSize)
+			assert.InDelta(t, tc.expected.x, actual.x, geom2d.GetEpsilon())
+			assert.InDelta(t, tc.expected.y, actual.y, geom2d.GetEpsilon())
+		})
+	}
+}
+
 func TestPoint_String(t *testing.T) {
 	tests := map[string]struct {
 		p        Point  // Supports different Point types with `any`
@@ -361,6 +650,101 @@ func TestPoint_String(t *testing.T) {
 	}
 }
 
+func TestPoint_StringWithPrecision(t *testing.T) {
+	tests := map[string]struct {
+		p        Point
+		decimals int
+		expected string
+	}{
+		"two decimals": {
+			p:        New(1.23456, -2.5),
+			decimals: 2,
+			expected: "(1.23,-2.50)",
+		},
+		"zero decimals": {
+			p:        New(1.6, 2.4),
+			decimals: 0,
+			expected: "(2,2)",
+		},
+		"negative decimals uses shortest representation": {
+			p:        New(0.1, 1.0/3),
+			decimals: -1,
+			expected: "(0.1,0.3333333333333333)",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			actual := tc.p.StringWithPrecision(tc.decimals)
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestPoint_ToPolar(t *testing.T) {
+	tests := map[string]struct {
+		point         Point
+		origin        Point
+		expectedR     float64
+		expectedTheta float64
+	}{
+		"positive X-axis": {
+			point:         New(2, 0),
+			origin:        New(0, 0),
+			expectedR:     2,
+			expectedTheta: 0,
+		},
+		"positive Y-axis": {
+			point:         New(0, 3),
+			origin:        New(0, 0),
+			expectedR:     3,
+			expectedTheta: math.Pi / 2,
+		},
+		"negative X-axis is pi": {
+			point:         New(-1, 0),
+			origin:        New(0, 0),
+			expectedR:     1,
+			expectedTheta: math.Pi,
+		},
+		"negative X-axis with negative zero": {
+			point:         New(-1, math.Copysign(0, -1)),
+			origin:        New(0, 0),
+			expectedR:     1,
+			expectedTheta: math.Pi,
+		},
+		"third quadrant is negative": {
+			point:         New(-1, -1),
+			origin:        New(0, 0),
+			expectedR:     math.Sqrt2,
+			expectedTheta: -3 * math.Pi / 4,
+		},
+		"relative to custom origin": {
+			point:         New(4, 6),
+			origin:        New(1, 2),
+			expectedR:     5,
+			expectedTheta: math.Atan2(4, 3),
+		},
+		"point at origin": {
+			point:         New(1, 2),
+			origin:        New(1, 2),
+			expectedR:     0,
+			expectedTheta: 0,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			r, theta := tc.point.ToPolar(tc.origin)
+			assert.InDelta(t, tc.expectedR, r, geom2d.GetEpsilon())
+			assert.InDelta(t, tc.expectedTheta, theta, geom2d.GetEpsilon())
+
+			// round trip
+			actual := NewFromPolar(tc.origin, r, theta)
+			assert.True(t, tc.point.Eq(actual), "expected %s, got %s", tc.point, actual)
+		})
+	}
+}
+
 func TestPoint_Translate(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -389,6 +773,28 @@ func TestPoint_Translate(t *testing.T) {
 	}
 }
 
+func TestPoint_WithinDistance(t *testing.T) {
+	tests := map[string]struct {
+		p, q     Point
+		d        float64
+		expected bool
+	}{
+		"inside":                {p: New(0, 0), q: New(1, 1), d: 2, expected: true},
+		"exactly on boundary":   {p: New(0, 0), q: New(3, 4), d: 5, expected: true},
+		"outside":               {p: New(0, 0), q: New(3, 4), d: 4.999, expected: false},
+		"same point, zero":      {p: New(1, 1), q: New(1, 1), d: 0, expected: true},
+		"negative distance":     {p: New(1, 1), q: New(1, 1), d: -1, expected: false},
+		"non-origin, symmetric": {p: New(-2, 5), q: New(1, 1), d: 5, expected: true},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.p.WithinDistance(tc.q, tc.d))
+			assert.Equal(t, tc.expected, tc.q.WithinDistance(tc.p, tc.d))
+		})
+	}
+}
+
 func TestPoint_X(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -481,3 +887,44 @@ func TestNewPointFromImagePoint(t *testing.T) {
 		})
 	}
 }
+
+func TestNewFromPolar(t *testing.T) {
+	tests := map[string]struct {
+		origin   Point
+		r, theta float64
+		expected Point
+	}{
+		"zero angle": {
+			origin:   New(0, 0),
+			r:        2,
+			theta:    0,
+			expected: New(2, 0),
+		},
+		"quarter turn from custom origin": {
+			origin:   New(1, 1),
+			r:        3,
+			theta:    math.Pi / 2,
+			expected: New(1, 4),
+		},
+		"angle beyond 2 pi": {
+			origin:   New(0, 0),
+			r:        1,
+			theta:    3 * math.Pi,
+			expected: New(-1, 0),
+		},
+		"matches Rotate convention": {
+			origin:   New(0, 0),
+			r:        1,
+			theta:    math.Pi / 3,
+			expected: New(1, 0).Rotate(New(0, 0), math.Pi/3),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			actual := NewFromPolar(tc.origin, tc.r, tc.theta)
+			assert.InDelta(t, tc.expected.x, actual.x, geom2d.GetEpsilon())
+			assert.InDelta(t, tc.expected.y, actual.y, geom2d.GetEpsilon())
+		})
+	}
+}
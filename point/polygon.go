@@ -0,0 +1,295 @@
+package point
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"slices"
+)
+
+// IsSimplePolygon reports whether a ring of points forms a simple polygon: one whose boundary does not
+// cross or touch itself.
+//
+// Parameters:
+//   - ring ([]Point): The vertices of the polygon, in order. The first point should not be repeated at the end.
+//
+// Returns:
+//   - bool: true if the ring has at least three vertices, no two edges that are not adjacent share a point,
+//     and no two adjacent edges share more than their common vertex; false otherwise.
+//
+// Behavior:
+//   - A ring with a repeated vertex, a vertex lying on another edge, or an edge that doubles back over the
+//     previous one is not simple. So a ring whose vertices are all collinear is never simple.
+//   - Vertices with a straight angle, collinear with their neighbours, are allowed.
+//   - Every pair of edges is compared, so this takes O(n²) time for a ring of n vertices. Touching is
+//     determined using [Orientation] and [Point.IsBetween], and so uses the global epsilon value.
+//   - The winding direction of the ring does not matter.
+func IsSimplePolygon(ring []Point) bool {
+	n := len(ring)
+	if n < 3 {
+		return false
+	}
+	for i := range n {
+		a, b, c := ring[i], ring[(i+1)%n], ring[(i+2)%n]
+		if a.Eq(b) || c.IsBetween(a, b) || a.IsBetween(b, c) {
+			return false
+		}
+		for j := i + 2; j < n; j++ {
+			if i == 0 && j == n-1 {
+				continue // adjacent across the end of the ring
+			}
+			if segmentsTouch(a, b, ring[j], ring[(j+1)%n]) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// MakeValidPolygon repairs a self-intersecting ring of points, splitting it into simple polygons.
+//
+// Imported polygons are often self-intersecting, such as a "bowtie" whose edges cross in the middle,
+// which breaks area and containment calculations. The ring is split at every point where it crosses or
+// touches itself, and each loop between two visits to the same point becomes a separate ring.
+//
+// Parameters:
+//   - ring ([]Point): The vertices of the polygon, in order. The first point should not be repeated at the end.
+//
+// Returns:
+//   - [][]Point: The repaired rings, each of which satisfies [IsSimplePolygon], in counterclockwise order.
+//     The first point of each is not repeated at the end. A ring that is already simple is returned unchanged,
+//     apart from its winding.
+//   - error: An error if the ring encloses no area, such as when it has fewer than three distinct vertices
+//     or all of its vertices are collinear.
+//
+// Behavior:
+//   - New vertices are inserted where edges cross. Their coordinates are computed in floating point.
+//   - Loops that enclose no area, such as where an edge doubles back over the previous one, are discarded.
+//   - The rings may touch each other at the vertices where the input was split. Where the input winds around
+//     a region more than once, one returned ring may lie inside another: this function does not decide
+//     whether such a ring is a hole, which depends on the fill rule the input was drawn with.
+//   - Every pair of edges is compared, so this takes O(n²) time for a ring of n vertices, plus the time
+//     to sort the split points along each edge.
+//   - The input slice is not modified.
+func MakeValidPolygon(ring []Point) ([][]Point, error) {
+	// remove repeated consecutive vertices, including across the end of the ring
+	vertices := slices.CompactFunc(slices.Clone(ring), Point.Eq)
+	for len(vertices) > 1 && vertices[0].Eq(vertices[len(vertices)-1]) {
+		vertices = vertices[:len(vertices)-1]
+	}
+	if len(vertices) < 3 {
+		return nil, fmt.Errorf("cannot make a valid polygon: at least 3 distinct vertices are required, got %d", len(vertices))
+	}
+
+	// the points at which each edge crosses or touches another edge, other than at its own endpoints
+	n := len(vertices)
+	splits := make([][]Point, n)
+	for i := range n {
+		a, b := vertices[i], vertices[(i+1)%n]
+		for j := i + 1; j < n; j++ {
+			c, d := vertices[j], vertices[(j+1)%n]
+			if p, ok := properCrossing(a, b, c, d); ok {
+				splits[i] = append(splits[i], p)
+				splits[j] = append(splits[j], p)
+				continue
+			}
+			for _, p := range []Point{c, d} {
+				if p.IsBetween(a, b) && !p.Eq(a) && !p.Eq(b) {
+					splits[i] = append(splits[i], p)
+				}
+			}
+			for _, p := range []Point{a, b} {
+				if p.IsBetween(c, d) && !p.Eq(c) && !p.Eq(d) {
+					splits[j] = append(splits[j], p)
+				}
+			}
+		}
+	}
+
+	// walk the ring with the split points in place, cutting off a loop whenever a point is revisited
+	var rings [][]Point
+	path := make([]Point, 0, n)
+	visit := func(p Point) {
+		if len(path) > 0 && p.Eq(path[len(path)-1]) {
+			return
+		}
+		if k := slices.IndexFunc(path, p.Eq); k >= 0 {
+			rings = appendValidRing(rings, slices.Clone(path[k:]))
+			path = path[:k+1]
+			return
+		}
+		path = append(path, p)
+	}
+	for i, a := range vertices {
+		visit(a)
+		slices.SortFunc(splits[i], func(p, q Point) int {
+			return cmp.Compare(a.DistanceSquaredToPoint(p), a.DistanceSquaredToPoint(q))
+		})
+		for _, p := range splits[i] {
+			visit(p)
+		}
+	}
+	rings = appendValidRing(rings, path)
+
+	if len(rings) == 0 {
+		return nil, fmt.Errorf("cannot make a valid polygon: ring of %d vertices encloses no area", n)
+	}
+	return rings, nil
+}
+
+// appendValidRing appends ring to rings in counterclockwise order, unless it encloses no area.
+func appendValidRing(rings [][]Point, ring []Point) [][]Point {
+	if len(RemoveCollinearPoints(ring)) < 3 {
+		return rings
+	}
+	if signedArea2X(ring) < 0 {
+		slices.Reverse(ring)
+	}
+	return append(rings, ring)
+}
+
+// properCrossing returns the point at which the line segments a-b and c-d cross, if they cross at a single
+// point interior to both. It reports false if they do not cross, or only touch.
+func properCrossing(a, b, c, d Point) (Point, bool) {
+	o1, o2 := Orientation(a, b, c), Orientation(a, b, d)
+	o3, o4 := Orientation(c, d, a), Orientation(c, d, b)
+	if o1 == Collinear || o2 == Collinear || o3 == Collinear || o4 == Collinear || o1 == o2 || o3 == o4 {
+		return Point{}, false
+	}
+	ab, cd := b.Sub(a), d.Sub(c)
+	t := c.Sub(a).CrossProduct(cd) / ab.CrossProduct(cd)
+	return New(a.x+ab.x*t, a.y+ab.y*t), true
+}
+
+// RegularPolygon generates the vertices of a regular polygon, inscribed in a circle.
+//
+// Parameters:
+//   - center (Point): The center of the polygon.
+//   - radius (float64): The distance from the center to each vertex (the circumradius).
+//   - sides (int): The number of sides (and vertices) of the polygon.
+//   - rotation (float64): The angle in radians, counterclockwise from the positive X-axis, of the first vertex.
+//
+// Returns:
+//   - []Point: The vertices of the polygon, in counterclockwise order. The first point is not repeated at the end.
+//   - error: An error if sides is less than three or radius is not positive.
+func RegularPolygon(center Point, radius float64, sides int, rotation float64) ([]Point, error) {
+	if sides < 3 {
+		return nil, fmt.Errorf("a regular polygon must have at least 3 sides, got %d", sides)
+	}
+	if !(radius > 0) {
+		return nil, fmt.Errorf("radius must be positive, got %v", radius)
+	}
+
+	vertices := make([]Point, sides)
+	for i := range vertices {
+		angle := rotation + 2*math.Pi*float64(i)/float64(sides)
+		vertices[i] = NewFromPolar(center, radius, angle)
+	}
+	return vertices, nil
+}
+
+// SimplePolygonFromPoints orders an unordered set of points to form a simple (non-self-intersecting) polygon.
+//
+// Unlike [ConvexHull], every distinct input point becomes a vertex of the polygon. This is useful for
+// reconstructing a shape from scattered samples.
+//
+// Parameters:
+//   - points ([]Point): The set of points. The slice is not modified.
+//
+// Returns:
+//   - []Point: The vertices of the polygon in counterclockwise order, starting from the leftmost point
+//     (the lowest, if there is more than one). The first point is not repeated at the end.
+//   - error: An error if there are fewer than three distinct points, or if all points are collinear.
+//
+// Behavior:
+//   - The polygon is built as two monotone chains. The leftmost and rightmost points are joined by a line;
+//     points below the line form the lower chain, visited from left to right, and points above it form
+//     the upper chain, visited from right to left. The resulting polygon is x-monotone, and so always simple.
+//   - Duplicate points are only included once.
+//   - Points lying on the line between the leftmost and rightmost points (as determined by [Orientation])
+//     are placed on the lower chain, unless no points lie above the line, in which case they are placed on
+//     the upper chain instead. Either way the chain they join is not the direct edge between the leftmost
+//     and rightmost points, which would otherwise pass through them. Collinear points are not removed, so
+//     the polygon may contain vertices with a straight angle; use [RemoveCollinearPoints] to remove them.
+//   - The polygon depends only on the set of points, not their order in the input.
+func SimplePolygonFromPoints(points []Point) ([]Point, error) {
+	sorted := slices.Clone(points)
+	slices.SortFunc(sorted, func(a, b Point) int {
+		return cmp.Or(cmp.Compare(a.x, b.x), cmp.Compare(a.y, b.y))
+	})
+	sorted = slices.CompactFunc(sorted, Point.Eq)
+
+	if len(sorted) < 3 {
+		return nil, fmt.Errorf("at least 3 distinct points are required to form a polygon, got %d", len(sorted))
+	}
+
+	left, right := sorted[0], sorted[len(sorted)-1]
+	inner := sorted[1 : len(sorted)-1]
+	hasAbove := slices.ContainsFunc(inner, func(p Point) bool { return Orientation(left, right, p) == Counterclockwise })
+	hasBelow := slices.ContainsFunc(inner, func(p Point) bool { return Orientation(left, right, p) == Clockwise })
+	if !hasAbove && !hasBelow {
+		return nil, fmt.Errorf("cannot form a polygon: all %d points are collinear", len(sorted))
+	}
+
+	lower := make([]Point, 0, len(sorted))
+	upper := make([]Point, 0, len(sorted))
+	for _, p := range inner {
+		switch Orientation(left, right, p) {
+		case Counterclockwise:
+			upper = append(upper, p)
+		case Clockwise:
+			lower = append(lower, p)
+		default:
+			// without points above the line, the upper chain would be the single edge from right
+			// to left, passing through any points on the line, so they go on the upper chain instead
+			if hasAbove {
+				lower = append(lower, p)
+			} else {
+				upper = append(upper, p)
+			}
+		}
+	}
+
+	polygon := make([]Point, 0, len(sorted))
+	polygon = append(polygon, left)
+	polygon = append(polygon, lower...)
+	polygon = append(polygon, right)
+	slices.Reverse(upper)
+	polygon = append(polygon, upper...)
+	return polygon, nil
+}
+
+// StarPolygon generates the vertices of a regular star polygon, alternating between an outer and inner radius.
+//
+// Stars are convenient as simple non-convex test geometry.
+//
+// Parameters:
+//   - center (Point): The center of the star.
+//   - outerRadius (float64): The distance from the center to each tip of the star.
+//   - innerRadius (float64): The distance from the center to each inner vertex between the tips.
+//   - points (int): The number of tips of the star.
+//
+// Returns:
+//   - []Point: The 2*points vertices of the star, in counterclockwise order, starting with a tip on the
+//     positive X-axis from the center. The first point is not repeated at the end.
+//   - error: An error if points is less than three, or the radii do not satisfy 0 < innerRadius < outerRadius.
+func StarPolygon(center Point, outerRadius, innerRadius float64, points int) ([]Point, error) {
+	if points < 3 {
+		return nil, fmt.Errorf("a star polygon must have at least 3 points, got %d", points)
+	}
+	if !(innerRadius > 0 && innerRadius < outerRadius) {
+		return nil, fmt.Errorf("radii must satisfy 0 < innerRadius < outerRadius, got innerRadius=%v, outerRadius=%v", innerRadius, outerRadius)
+	}
+
+	n := 2 * points
+	vertices := make([]Point, n)
+	for i := range vertices {
+		radius := outerRadius
+		if i%2 == 1 {
+			radius = innerRadius
+		}
+		angle := 2 * math.Pi * float64(i) / float64(n)
+		vertices[i] = NewFromPolar(center, radius, angle)
+	}
+	return vertices, nil
+}
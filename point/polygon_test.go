@@ -0,0 +1,341 @@
+package point
+
+import (
+	"github.com/mikenye/geom2d"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"math"
+	"slices"
+	"testing"
+)
+
+func TestIsSimplePolygon(t *testing.T) {
+	star, err := StarPolygon(New(0, 0), 2, 1, 5)
+	require.NoError(t, err)
+	pentagon, err := RegularPolygon(New(0, 0), 1, 5, 0)
+	require.NoError(t, err)
+
+	tests := map[string]struct {
+		ring     []Point
+		expected bool
+	}{
+		"square":                         {ring: []Point{New(0, 0), New(1, 0), New(1, 1), New(0, 1)}, expected: true},
+		"clockwise square":               {ring: []Point{New(0, 0), New(0, 1), New(1, 1), New(1, 0)}, expected: true},
+		"star polygon":                   {ring: star, expected: true},
+		"straight angle is allowed":      {ring: []Point{New(0, 0), New(1, 0), New(2, 0), New(1, 1)}, expected: true},
+		"bowtie":                         {ring: []Point{New(0, 0), New(2, 2), New(2, 0), New(0, 2)}, expected: false},
+		"pentagram":                      {ring: []Point{pentagon[0], pentagon[2], pentagon[4], pentagon[1], pentagon[3]}, expected: false},
+		"vertex touching another edge":   {ring: []Point{New(0, 0), New(4, 0), New(2, 0), New(2, -1), New(4, -1), New(4, 2), New(0, 2)}, expected: false},
+		"repeated vertex":                {ring: []Point{New(0, 0), New(2, 0), New(2, 2), New(1, 1), New(2, 0), New(3, -1)}, expected: false},
+		"consecutive duplicate vertices": {ring: []Point{New(0, 0), New(1, 0), New(1, 0), New(0, 1)}, expected: false},
+		"edge doubling back":             {ring: []Point{New(0, 0), New(2, 0), New(1, 0), New(1, 1)}, expected: false},
+		"all collinear":                  {ring: []Point{New(0, 0), New(1, 0), New(2, 0)}, expected: false},
+		"too few points":                 {ring: []Point{New(0, 0), New(1, 0)}, expected: false},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, IsSimplePolygon(tc.ring))
+		})
+	}
+}
+
+func TestMakeValidPolygon(t *testing.T) {
+	pentagon, err := RegularPolygon(New(0, 0), 1, 5, 0)
+	require.NoError(t, err)
+	pentagram := []Point{pentagon[0], pentagon[2], pentagon[4], pentagon[1], pentagon[3]}
+	square := []Point{New(0, 0), New(1, 0), New(1, 1), New(0, 1)}
+
+	tests := map[string]struct {
+		ring          []Point
+		expectedRings int
+		expectedArea  float64
+	}{
+		"simple ring is unchanged": {
+			ring:          square,
+			expectedRings: 1,
+			expectedArea:  1,
+		},
+		"bowtie splits into two triangles": {
+			ring:          []Point{New(0, 0), New(2, 2), New(2, 0), New(0, 2)},
+			expectedRings: 2,
+			expectedArea:  2,
+		},
+		"figure eight touching at a vertex": {
+			ring:          []Point{New(0, 0), New(1, 0), New(1, 1), New(2, 1), New(2, 2), New(1, 2), New(1, 1), New(0, 1)},
+			expectedRings: 2,
+			expectedArea:  2,
+		},
+		"vertex touching another edge": {
+			ring:          []Point{New(0, 0), New(4, 0), New(4, 2), New(2, 0), New(2, 2), New(0, 2)},
+			expectedRings: 2,
+			expectedArea:  4 + 2,
+		},
+		"pentagram splits into two nested rings, covering the centre twice": {
+			ring:          pentagram,
+			expectedRings: 2,
+			expectedArea:  signedArea2X(pentagram) / 2,
+		},
+		"spike is removed": {
+			ring:          []Point{New(0, 0), New(1, 0), New(1, 1), New(1, 3), New(1, 1), New(0, 1)},
+			expectedRings: 1,
+			expectedArea:  1,
+		},
+		"repeated closing point": {
+			ring:          []Point{New(0, 0), New(1, 0), New(1, 1), New(0, 1), New(0, 0)},
+			expectedRings: 1,
+			expectedArea:  1,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			input := slices.Clone(tc.ring)
+			rings, err := MakeValidPolygon(tc.ring)
+			require.NoError(t, err)
+			assert.Equal(t, input, tc.ring, "input should not be modified")
+			require.Len(t, rings, tc.expectedRings)
+
+			area := 0.0
+			for _, ring := range rings {
+				assert.True(t, IsSimplePolygon(ring), "ring %v should be simple", ring)
+				assert.Positive(t, signedArea2X(ring), "ring %v should be counterclockwise", ring)
+				area += PolygonArea(ring)
+			}
+			if tc.expectedArea > 0 {
+				assert.InDelta(t, tc.expectedArea, area, geom2d.GetEpsilon())
+			}
+		})
+	}
+
+	t.Run("simple ring keeps its vertices", func(t *testing.T) {
+		rings, err := MakeValidPolygon(square)
+		require.NoError(t, err)
+		assert.Equal(t, [][]Point{square}, rings)
+	})
+}
+
+func TestMakeValidPolygon_Errors(t *testing.T) {
+	tests := map[string]struct {
+		ring        []Point
+		expectedErr string
+	}{
+		"too few distinct points": {
+			ring:        []Point{New(0, 0), New(1, 0), New(1, 0), New(0, 0)},
+			expectedErr: "cannot make a valid polygon: at least 3 distinct vertices are required, got 2",
+		},
+		"all collinear": {
+			ring:        []Point{New(0, 0), New(1, 0), New(3, 0)},
+			expectedErr: "cannot make a valid polygon: ring of 3 vertices encloses no area",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := MakeValidPolygon(tc.ring)
+			assert.EqualError(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestRegularPolygon(t *testing.T) {
+	tests := map[string]struct {
+		center      Point
+		radius      float64
+		sides       int
+		rotation    float64
+		expected    []Point
+		expectedErr bool
+	}{
+		"square": {
+			center:   New(1, 1),
+			radius:   1,
+			sides:    4,
+			rotation: 0,
+			expected: []Point{New(2, 1), New(1, 2), New(0, 1), New(1, 0)},
+		},
+		"rotated square": {
+			center:   New(0, 0),
+			radius:   math.Sqrt2,
+			sides:    4,
+			rotation: math.Pi / 4,
+			expected: []Point{New(1, 1), New(-1, 1), New(-1, -1), New(1, -1)},
+		},
+		"fewer than three sides": {
+			center:      New(0, 0),
+			radius:      1,
+			sides:       2,
+			expectedErr: true,
+		},
+		"zero radius": {
+			center:      New(0, 0),
+			radius:      0,
+			sides:       5,
+			expectedErr: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			actual, err := RegularPolygon(tc.center, tc.radius, tc.sides, tc.rotation)
+			if tc.expectedErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, actual, len(tc.expected))
+			for i := range tc.expected {
+				assert.True(t, tc.expected[i].Eq(actual[i]), "vertex %d: expected %s, got %s", i, tc.expected[i], actual[i])
+			}
+		})
+	}
+
+	t.Run("hexagon area", func(t *testing.T) {
+		hexagon, err := RegularPolygon(New(0, 0), 2, 6, 0)
+		require.NoError(t, err)
+		assert.InDelta(t, 6*math.Sqrt(3), PolygonArea(hexagon), geom2d.GetEpsilon())
+		assert.Positive(t, signedArea2X(hexagon), "vertices should be counterclockwise")
+	})
+}
+
+func TestSimplePolygonFromPoints(t *testing.T) {
+	tests := map[string]struct {
+		points   []Point
+		expected []Point
+	}{
+		"square in any order": {
+			points:   []Point{New(1, 1), New(0, 0), New(0, 1), New(1, 0)},
+			expected: []Point{New(0, 0), New(1, 0), New(1, 1), New(0, 1)},
+		},
+		"interior points are kept": {
+			points:   []Point{New(0, 0), New(4, 0), New(4, 4), New(0, 4), New(1, 3), New(3, 1)},
+			expected: []Point{New(0, 0), New(3, 1), New(4, 0), New(4, 4), New(1, 3), New(0, 4)},
+		},
+		"duplicates are removed": {
+			points:   []Point{New(0, 0), New(2, 0), New(1, 2), New(2, 0), New(0, 0)},
+			expected: []Point{New(0, 0), New(2, 0), New(1, 2)},
+		},
+		"collinear points on the dividing line go on the lower chain": {
+			points:   []Point{New(0, 0), New(1, 0), New(2, 0), New(1, 1)},
+			expected: []Point{New(0, 0), New(1, 0), New(2, 0), New(1, 1)},
+		},
+		"all points below the dividing line": {
+			points:   []Point{New(0, 0), New(2, 0), New(1, -1)},
+			expected: []Point{New(0, 0), New(1, -1), New(2, 0)},
+		},
+		"collinear points on the dividing line go on the upper chain when it would be empty": {
+			points:   []Point{New(0, 0), New(2, -1), New(2, 0), New(4, 0)},
+			expected: []Point{New(0, 0), New(2, -1), New(4, 0), New(2, 0)},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			input := slices.Clone(tc.points)
+			actual, err := SimplePolygonFromPoints(tc.points)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, actual)
+			assert.Equal(t, input, tc.points, "input should not be modified")
+			assert.Greater(t, signedArea2X(actual), 0.0, "polygon should be counterclockwise")
+		})
+	}
+}
+
+func TestSimplePolygonFromPoints_IsSimple(t *testing.T) {
+	scatter := make([]Point, 0, 50)
+	for i := 0; i < 50; i++ {
+		// deterministic scatter, including repeated columns
+		scatter = append(scatter, New(float64((i*37)%17), float64((i*53)%29)))
+	}
+
+	tests := map[string][]Point{
+		"scatter": scatter,
+		"points on the dividing line with all others below": {
+			New(0, 0), New(2, -1), New(2, 0), New(4, 0), New(1, 0), New(3, -2),
+		},
+		"points on the dividing line with all others above": {
+			New(0, 0), New(2, 1), New(2, 0), New(4, 0), New(1, 0), New(3, 2),
+		},
+	}
+
+	for name, points := range tests {
+		t.Run(name, func(t *testing.T) {
+			polygon, err := SimplePolygonFromPoints(points)
+			require.NoError(t, err)
+			require.Len(t, polygon, len(points))
+			assert.True(t, IsSimplePolygon(polygon), "polygon %v should be simple", polygon)
+
+			// no two non-adjacent edges cross or touch, and no vertex lies on a non-adjacent edge
+			n := len(polygon)
+			for i := 0; i < n; i++ {
+				a, b := polygon[i], polygon[(i+1)%n]
+				for j := i + 2; j < n; j++ {
+					if i == 0 && j == n-1 {
+						continue // adjacent across the end of the ring
+					}
+					c, d := polygon[j], polygon[(j+1)%n]
+					crosses := Orientation(a, b, c) != Orientation(a, b, d) && Orientation(c, d, a) != Orientation(c, d, b)
+					assert.False(t, crosses, "edges %s-%s and %s-%s cross", a, b, c, d)
+					touches := a.IsBetween(c, d) || b.IsBetween(c, d) || c.IsBetween(a, b) || d.IsBetween(a, b)
+					assert.False(t, touches, "edges %s-%s and %s-%s touch", a, b, c, d)
+				}
+			}
+		})
+	}
+}
+
+func TestSimplePolygonFromPoints_Errors(t *testing.T) {
+	tests := map[string]struct {
+		points      []Point
+		expectedErr string
+	}{
+		"too few points": {
+			points:      []Point{New(0, 0), New(1, 1), New(1, 1)},
+			expectedErr: "at least 3 distinct points are required to form a polygon, got 2",
+		},
+		"all collinear": {
+			points:      []Point{New(0, 0), New(1, 1), New(2, 2)},
+			expectedErr: "cannot form a polygon: all 3 points are collinear",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := SimplePolygonFromPoints(tc.points)
+			assert.EqualError(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestStarPolygon(t *testing.T) {
+	t.Run("five pointed star", func(t *testing.T) {
+		star, err := StarPolygon(New(1, 1), 2, 1, 5)
+		require.NoError(t, err)
+		require.Len(t, star, 10)
+		assert.True(t, New(3, 1).Eq(star[0]), "first tip should be on the positive X-axis, got %s", star[0])
+		for i, p := range star {
+			expectedRadius := 2.0
+			if i%2 == 1 {
+				expectedRadius = 1.0
+			}
+			assert.InDelta(t, expectedRadius, p.DistanceToPoint(New(1, 1)), geom2d.GetEpsilon())
+		}
+		assert.Positive(t, signedArea2X(star), "vertices should be counterclockwise")
+	})
+
+	errTests := map[string]struct {
+		outerRadius, innerRadius float64
+		points                   int
+	}{
+		"fewer than three points": {outerRadius: 2, innerRadius: 1, points: 2},
+		"inner radius too large":  {outerRadius: 2, innerRadius: 2, points: 5},
+		"zero inner radius":       {outerRadius: 2, innerRadius: 0, points: 5},
+	}
+
+	for name, tc := range errTests {
+		t.Run(name, func(t *testing.T) {
+			_, err := StarPolygon(New(0, 0), tc.outerRadius, tc.innerRadius, tc.points)
+			assert.Error(t, err)
+		})
+	}
+}
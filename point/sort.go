@@ -0,0 +1,54 @@
+package point
+
+import (
+	"cmp"
+	"slices"
+)
+
+// SortPointsByAngle sorts a slice of points in place, counterclockwise by angle around a center point.
+//
+// This is a building block for algorithms such as gift-wrapping and fan triangulation, and for ordering vertices
+// when constructing polygons.
+//
+// Parameters:
+//   - points ([]Point): The points to sort. The slice is modified in place.
+//   - center (Point): The point around which angles are measured.
+//
+// Behavior:
+//   - Angles are measured counterclockwise from the positive X-axis, in the range [0, 2π), consistent with
+//     [Point.Rotate]. Points directly to the right of the center come first.
+//   - Points at the same angle (collinear with the center, on the same side) are ordered nearest-first.
+//   - Points equal to the center have no defined angle, and are placed before all other points.
+//   - Angles are compared exactly using cross products rather than trigonometric functions, so the ordering
+//     is consistent and not subject to rounding in [math.Atan2].
+func SortPointsByAngle(points []Point, center Point) {
+	slices.SortStableFunc(points, func(a, b Point) int {
+		va, vb := a.Sub(center), b.Sub(center)
+
+		if c := cmp.Compare(angleHalf(va), angleHalf(vb)); c != 0 {
+			return c
+		}
+
+		// both in the same half-plane, so the cross product gives their relative angle
+		if cross := va.CrossProduct(vb); cross > 0 {
+			return -1
+		} else if cross < 0 {
+			return 1
+		}
+
+		return cmp.Compare(va.DotProduct(va), vb.DotProduct(vb))
+	})
+}
+
+// angleHalf classifies a vector by the half of the plane its angle lies in, for sorting by angle.
+// It returns 0 for the zero vector, 1 for angles in [0, π), and 2 for angles in [π, 2π).
+func angleHalf(v Point) int {
+	switch {
+	case v.x == 0 && v.y == 0:
+		return 0
+	case v.y > 0 || (v.y == 0 && v.x > 0):
+		return 1
+	default:
+		return 2
+	}
+}
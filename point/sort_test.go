@@ -0,0 +1,57 @@
+package point
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestSortPointsByAngle(t *testing.T) {
+	tests := map[string]struct {
+		points   []Point
+		center   Point
+		expected []Point
+	}{
+		"one point per axis": {
+			points:   []Point{New(0, -1), New(-1, 0), New(0, 1), New(1, 0)},
+			center:   New(0, 0),
+			expected: []Point{New(1, 0), New(0, 1), New(-1, 0), New(0, -1)},
+		},
+		"all quadrants": {
+			points:   []Point{New(1, -1), New(-1, -1), New(-1, 1), New(1, 1)},
+			center:   New(0, 0),
+			expected: []Point{New(1, 1), New(-1, 1), New(-1, -1), New(1, -1)},
+		},
+		"same angle ordered nearest-first": {
+			points:   []Point{New(3, 3), New(0, 2), New(1, 1), New(2, 2)},
+			center:   New(0, 0),
+			expected: []Point{New(1, 1), New(2, 2), New(3, 3), New(0, 2)},
+		},
+		"custom center": {
+			points:   []Point{New(5, 4), New(6, 5), New(5, 6), New(4, 5)},
+			center:   New(5, 5),
+			expected: []Point{New(6, 5), New(5, 6), New(4, 5), New(5, 4)},
+		},
+		"point equal to center comes first": {
+			points:   []Point{New(0, 1), New(0, 0), New(1, 0)},
+			center:   New(0, 0),
+			expected: []Point{New(0, 0), New(1, 0), New(0, 1)},
+		},
+		"just below the positive X-axis comes last": {
+			points:   []Point{New(1, -1e-9), New(1, 0), New(-1, 1e-9)},
+			center:   New(0, 0),
+			expected: []Point{New(1, 0), New(-1, 1e-9), New(1, -1e-9)},
+		},
+		"empty": {
+			points:   []Point{},
+			center:   New(0, 0),
+			expected: []Point{},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			SortPointsByAngle(tc.points, tc.center)
+			assert.Equal(t, tc.expected, tc.points)
+		})
+	}
+}
@@ -0,0 +1,112 @@
+// Package projection provides simple map projections, for converting geographic coordinates into
+// planar coordinates so that the planar algorithms of geom2d can be applied to them.
+//
+// # Overview
+//
+// Geographic coordinates are represented as a [point.Point] whose X-coordinate is the longitude and
+// whose Y-coordinate is the latitude, both in degrees. This matches the convention used by
+// [point.Point.DistanceToPointHaversine]. Projected coordinates are in meters.
+//
+// # Features
+//
+//   - Web Mercator: WebMercatorProject and WebMercatorUnproject convert to and from the spherical
+//     Mercator projection (EPSG:3857) used by most web maps.
+//   - Equirectangular: EquirectangularProject and EquirectangularUnproject convert to and from the
+//     equirectangular projection, which is simple and accurate for small areas near its standard parallel.
+//
+// # Notes
+//
+//   - These projections treat the Earth as a sphere, and distort distances and areas away from the equator
+//     (or the standard parallel). Results of planar algorithms on projected data are therefore approximate.
+//   - Planar-only users of geom2d do not need this package.
+package projection
+
+import (
+	"github.com/mikenye/geom2d/point"
+	"math"
+)
+
+// EarthRadius is the radius of the Earth in meters used by the projections in this package.
+// It is the equatorial radius of the WGS 84 ellipsoid, as used by Web Mercator.
+const EarthRadius = 6378137.0
+
+// WebMercatorMaxLatitude is the largest latitude, in degrees, that can be represented in Web Mercator.
+// At this latitude the projected map is square, extending from -π·[EarthRadius] to π·[EarthRadius] in both X and Y.
+var WebMercatorMaxLatitude = 2*math.Atan(math.Exp(math.Pi))*180/math.Pi - 90
+
+// EquirectangularProject projects geographic coordinates using the equirectangular projection.
+//
+// Parameters:
+//   - lonLat (point.Point): The longitude (X) and latitude (Y) to project, in degrees.
+//   - standardParallel (float64): The latitude, in degrees, at which the projection has no distortion.
+//     Using a latitude near the center of the data gives the most accurate results.
+//
+// Returns:
+//   - point.Point: The projected coordinates, in meters.
+func EquirectangularProject(lonLat point.Point, standardParallel float64) point.Point {
+	return point.New(
+		EarthRadius*degreesToRadians(lonLat.X())*math.Cos(degreesToRadians(standardParallel)),
+		EarthRadius*degreesToRadians(lonLat.Y()),
+	)
+}
+
+// EquirectangularUnproject converts coordinates projected by [EquirectangularProject] back to geographic coordinates.
+//
+// Parameters:
+//   - p (point.Point): The projected coordinates, in meters.
+//   - standardParallel (float64): The standard parallel, in degrees, used to project p.
+//
+// Returns:
+//   - point.Point: The longitude (X) and latitude (Y), in degrees.
+//
+// Notes:
+//   - If the standard parallel is ±90°, the longitude cannot be recovered, and is infinite or NaN.
+func EquirectangularUnproject(p point.Point, standardParallel float64) point.Point {
+	return point.New(
+		radiansToDegrees(p.X()/(EarthRadius*math.Cos(degreesToRadians(standardParallel)))),
+		radiansToDegrees(p.Y()/EarthRadius),
+	)
+}
+
+// WebMercatorProject projects geographic coordinates using the spherical Web Mercator projection (EPSG:3857).
+//
+// Parameters:
+//   - lonLat (point.Point): The longitude (X) and latitude (Y) to project, in degrees.
+//
+// Returns:
+//   - point.Point: The projected coordinates, in meters.
+//
+// Behavior:
+//   - Latitudes beyond ±[WebMercatorMaxLatitude] are clamped to it, as the poles cannot be represented.
+//   - Longitudes are not wrapped, so longitudes outside [-180, 180] project outside the usual map extent.
+func WebMercatorProject(lonLat point.Point) point.Point {
+	lat := math.Max(-WebMercatorMaxLatitude, math.Min(WebMercatorMaxLatitude, lonLat.Y()))
+	return point.New(
+		EarthRadius*degreesToRadians(lonLat.X()),
+		EarthRadius*math.Log(math.Tan(math.Pi/4+degreesToRadians(lat)/2)),
+	)
+}
+
+// WebMercatorUnproject converts coordinates projected by [WebMercatorProject] back to geographic coordinates.
+//
+// Parameters:
+//   - p (point.Point): The projected coordinates, in meters.
+//
+// Returns:
+//   - point.Point: The longitude (X) and latitude (Y), in degrees.
+func WebMercatorUnproject(p point.Point) point.Point {
+	return point.New(
+		radiansToDegrees(p.X()/EarthRadius),
+		radiansToDegrees(2*math.Atan(math.Exp(p.Y()/EarthRadius))-math.Pi/2),
+	)
+}
+
+// degreesToRadians converts an angle from degrees to radians.
+func degreesToRadians(degrees float64) float64 {
+	return degrees * math.Pi / 180
+}
+
+// radiansToDegrees converts an angle from radians to degrees.
+func radiansToDegrees(radians float64) float64 {
+	return radians * 180 / math.Pi
+}
@@ -0,0 +1,92 @@
+package projection
+
+import (
+	"github.com/mikenye/geom2d/point"
+	"github.com/stretchr/testify/assert"
+	"math"
+	"testing"
+)
+
+func TestEquirectangularProject(t *testing.T) {
+	tests := map[string]struct {
+		lonLat           point.Point
+		standardParallel float64
+		expected         point.Point
+	}{
+		"origin": {
+			lonLat:   point.New(0, 0),
+			expected: point.New(0, 0),
+		},
+		"antimeridian at equator": {
+			lonLat:   point.New(180, 0),
+			expected: point.New(math.Pi*EarthRadius, 0),
+		},
+		"north pole": {
+			lonLat:   point.New(0, 90),
+			expected: point.New(0, math.Pi/2*EarthRadius),
+		},
+		"standard parallel at 60 degrees halves X": {
+			lonLat:           point.New(90, 45),
+			standardParallel: 60,
+			expected:         point.New(math.Pi/4*EarthRadius, math.Pi/4*EarthRadius),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			actual := EquirectangularProject(tc.lonLat, tc.standardParallel)
+			assert.InDelta(t, tc.expected.X(), actual.X(), 1e-6)
+			assert.InDelta(t, tc.expected.Y(), actual.Y(), 1e-6)
+
+			roundTrip := EquirectangularUnproject(actual, tc.standardParallel)
+			assert.InDelta(t, tc.lonLat.X(), roundTrip.X(), 1e-9)
+			assert.InDelta(t, tc.lonLat.Y(), roundTrip.Y(), 1e-9)
+		})
+	}
+}
+
+func TestWebMercatorProject(t *testing.T) {
+	tests := map[string]struct {
+		lonLat   point.Point
+		expected point.Point
+	}{
+		"origin": {
+			lonLat:   point.New(0, 0),
+			expected: point.New(0, 0),
+		},
+		"antimeridian": {
+			lonLat:   point.New(180, 0),
+			expected: point.New(20037508.342789244, 0),
+		},
+		"maximum latitude": {
+			lonLat:   point.New(-180, WebMercatorMaxLatitude),
+			expected: point.New(-20037508.342789244, 20037508.342789244),
+		},
+		"45 degrees north": {
+			lonLat:   point.New(90, 45),
+			expected: point.New(10018754.171394622, 5621521.486192066),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			actual := WebMercatorProject(tc.lonLat)
+			assert.InDelta(t, tc.expected.X(), actual.X(), 0.1)
+			assert.InDelta(t, tc.expected.Y(), actual.Y(), 0.1)
+
+			roundTrip := WebMercatorUnproject(actual)
+			assert.InDelta(t, tc.lonLat.X(), roundTrip.X(), 1e-9)
+			assert.InDelta(t, tc.lonLat.Y(), roundTrip.Y(), 1e-9)
+		})
+	}
+}
+
+func TestWebMercatorProject_ClampsLatitude(t *testing.T) {
+	expected := WebMercatorProject(point.New(10, WebMercatorMaxLatitude))
+	assert.Equal(t, expected, WebMercatorProject(point.New(10, 90)))
+	assert.InDelta(t, 85.0511287798, WebMercatorMaxLatitude, 1e-9)
+
+	south := WebMercatorProject(point.New(10, -90))
+	assert.Equal(t, expected.X(), south.X())
+	assert.InDelta(t, -expected.Y(), south.Y(), 1e-6)
+}
@@ -0,0 +1,106 @@
+// Package random generates random geometry, for producing reproducible test inputs and benchmarks.
+//
+// # Overview
+//
+// Every function takes a caller-supplied [rand.Rand], so that the same seed always produces the same geometry.
+// Coordinates are drawn uniformly from within a [rectangle.Rectangle].
+//
+// # Features
+//
+//   - Points generates random points within a rectangle.
+//   - LineSegments generates random line segments, with both endpoints within a rectangle.
+//   - SimplePolygon generates a random simple (non-self-intersecting) polygon within a rectangle.
+//
+// # Usage
+//
+//	rng := rand.New(rand.NewPCG(1, 2))
+//	segments := random.LineSegments(100, rectangle.New(0, 0, 1000, 1000), rng)
+package random
+
+import (
+	"fmt"
+	"github.com/mikenye/geom2d/linesegment"
+	"github.com/mikenye/geom2d/point"
+	"github.com/mikenye/geom2d/rectangle"
+	"math/rand/v2"
+)
+
+// LineSegments generates random line segments within a rectangle.
+//
+// Parameters:
+//   - n (int): The number of line segments to generate.
+//   - bounds (rectangle.Rectangle): The rectangle within which both endpoints of every line segment lie.
+//   - rng (*rand.Rand): The source of randomness.
+//
+// Returns:
+//   - []linesegment.LineSegment: The generated line segments. If n is not positive, the slice is empty.
+//
+// Notes:
+//   - Degenerate line segments are possible, but vanishingly unlikely unless bounds has zero width or height.
+func LineSegments(n int, bounds rectangle.Rectangle, rng *rand.Rand) []linesegment.LineSegment {
+	segments := make([]linesegment.LineSegment, 0, max(n, 0))
+	for i := 0; i < n; i++ {
+		segments = append(segments, linesegment.NewFromPoints(randomPoint(bounds, rng), randomPoint(bounds, rng)))
+	}
+	return segments
+}
+
+// Points generates random points within a rectangle.
+//
+// Parameters:
+//   - n (int): The number of points to generate.
+//   - bounds (rectangle.Rectangle): The rectangle within which every point lies.
+//   - rng (*rand.Rand): The source of randomness.
+//
+// Returns:
+//   - []point.Point: The generated points. If n is not positive, the slice is empty.
+func Points(n int, bounds rectangle.Rectangle, rng *rand.Rand) []point.Point {
+	points := make([]point.Point, 0, max(n, 0))
+	for i := 0; i < n; i++ {
+		points = append(points, randomPoint(bounds, rng))
+	}
+	return points
+}
+
+// SimplePolygon generates a random simple polygon within a rectangle.
+//
+// The polygon is built by generating random points and sorting them by angle around their centroid,
+// using [point.SortPointsByAngle]. The result is star-shaped around the centroid, so its edges do not cross.
+//
+// Parameters:
+//   - n (int): The number of vertices of the polygon.
+//   - bounds (rectangle.Rectangle): The rectangle within which every vertex lies.
+//   - rng (*rand.Rand): The source of randomness.
+//
+// Returns:
+//   - []point.Point: The vertices of the polygon in counterclockwise order. The first point is not repeated at the end.
+//   - error: An error if n is less than three, or if bounds has zero area.
+func SimplePolygon(n int, bounds rectangle.Rectangle, rng *rand.Rand) ([]point.Point, error) {
+	if n < 3 {
+		return nil, fmt.Errorf("a polygon requires at least 3 vertices, got %d", n)
+	}
+	if bounds.Area() == 0 {
+		return nil, fmt.Errorf("bounds %s must have a non-zero area", bounds)
+	}
+
+	points := Points(n, bounds, rng)
+
+	var sumX, sumY float64
+	for _, p := range points {
+		sumX += p.X()
+		sumY += p.Y()
+	}
+	centroid := point.New(sumX/float64(n), sumY/float64(n))
+
+	point.SortPointsByAngle(points, centroid)
+	return points, nil
+}
+
+// randomPoint returns a point drawn uniformly from within bounds.
+func randomPoint(bounds rectangle.Rectangle, rng *rand.Rand) point.Point {
+	bottomLeft := bounds.BottomLeft()
+	return point.New(
+		bottomLeft.X()+rng.Float64()*bounds.Width(),
+		bottomLeft.Y()+rng.Float64()*bounds.Height(),
+	)
+}
@@ -0,0 +1,85 @@
+package random
+
+import (
+	"github.com/mikenye/geom2d/linesegment"
+	"github.com/mikenye/geom2d/rectangle"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"math/rand/v2"
+	"testing"
+)
+
+func TestLineSegments(t *testing.T) {
+	bounds := rectangle.New(-10, 5, 20, 15)
+
+	segments := LineSegments(50, bounds, rand.New(rand.NewPCG(1, 2)))
+	require.Len(t, segments, 50)
+	for _, l := range segments {
+		assert.True(t, bounds.ContainsPoint(l.Upper()), "upper point %s is outside %s", l.Upper(), bounds)
+		assert.True(t, bounds.ContainsPoint(l.Lower()), "lower point %s is outside %s", l.Lower(), bounds)
+	}
+
+	// the same seed gives the same segments
+	assert.Equal(t, segments, LineSegments(50, bounds, rand.New(rand.NewPCG(1, 2))))
+	assert.NotEqual(t, segments, LineSegments(50, bounds, rand.New(rand.NewPCG(3, 4))))
+
+	assert.Empty(t, LineSegments(0, bounds, rand.New(rand.NewPCG(1, 2))))
+	assert.Empty(t, LineSegments(-1, bounds, rand.New(rand.NewPCG(1, 2))))
+}
+
+func TestPoints(t *testing.T) {
+	bounds := rectangle.New(0, 0, 1, 1)
+
+	points := Points(100, bounds, rand.New(rand.NewPCG(1, 2)))
+	require.Len(t, points, 100)
+	for _, p := range points {
+		assert.True(t, bounds.ContainsPoint(p), "point %s is outside %s", p, bounds)
+	}
+	assert.Equal(t, points, Points(100, bounds, rand.New(rand.NewPCG(1, 2))))
+}
+
+func TestSimplePolygon(t *testing.T) {
+	bounds := rectangle.New(0, 0, 100, 100)
+
+	for _, n := range []int{3, 4, 10, 100} {
+		rng := rand.New(rand.NewPCG(uint64(n), 42))
+		polygon, err := SimplePolygon(n, bounds, rng)
+		require.NoError(t, err)
+		require.Len(t, polygon, n)
+
+		for _, p := range polygon {
+			assert.True(t, bounds.ContainsPoint(p), "point %s is outside %s", p, bounds)
+		}
+
+		// counterclockwise, so the signed (shoelace) area is positive
+		var signedArea2X float64
+		for i, p := range polygon {
+			signedArea2X += p.CrossProduct(polygon[(i+1)%n])
+		}
+		assert.Greater(t, signedArea2X, 0.0)
+
+		// no two non-adjacent edges intersect
+		edges := make([]linesegment.LineSegment, n)
+		for i := range polygon {
+			edges[i] = linesegment.NewFromPoints(polygon[i], polygon[(i+1)%n])
+		}
+		for i := 0; i < n; i++ {
+			for j := i + 2; j < n; j++ {
+				if i == 0 && j == n-1 {
+					continue // adjacent across the end of the ring
+				}
+				assert.False(t, edges[i].Intersects(edges[j]), "n=%d: edges %s and %s intersect", n, edges[i], edges[j])
+			}
+		}
+	}
+}
+
+func TestSimplePolygon_Errors(t *testing.T) {
+	rng := rand.New(rand.NewPCG(1, 2))
+
+	_, err := SimplePolygon(2, rectangle.New(0, 0, 1, 1), rng)
+	assert.Error(t, err)
+
+	_, err = SimplePolygon(5, rectangle.New(0, 0, 0, 1), rng)
+	assert.Error(t, err)
+}
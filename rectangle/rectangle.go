@@ -9,7 +9,19 @@ import (
 	"image"
 )
 
+// Bounded is implemented by geometric types that can report an axis-aligned bounding box.
+//
+// It allows broad-phase code (for example, collision or spatial indexing) to be written
+// generically over any shape that exposes a BoundingBox method.
+type Bounded interface {
+	BoundingBox() Rectangle
+}
+
 // Rectangle represents an axis-aligned rectangle defined by its four corners.
+//
+// Corners are named using the mathematical convention of the Y-axis pointing upwards, so the "top" corners
+// have the greatest y-coordinate. In screen coordinates, where the Y-axis points downwards (such as with
+// [image.Rectangle]), the "top" corners appear at the bottom of the rectangle.
 type Rectangle struct {
 	topLeft     point.Point
 	topRight    point.Point
@@ -57,6 +69,23 @@ func NewFromImageRect(r image.Rectangle) Rectangle {
 	)
 }
 
+// NewFromLineSegment creates a new Rectangle that is the axis-aligned bounding box of a [linesegment.LineSegment].
+//
+// Parameters:
+//   - l (linesegment.LineSegment): The line segment to bound.
+//
+// Returns:
+//   - Rectangle: The smallest axis-aligned rectangle containing both endpoints of l.
+//
+// Notes:
+//   - Horizontal, vertical or degenerate line segments produce a rectangle with zero width and/or height.
+//   - This is provided as a constructor rather than as a BoundingBox method on [linesegment.LineSegment]
+//     because the rectangle package depends on the linesegment package.
+func NewFromLineSegment(l linesegment.LineSegment) Rectangle {
+	upper, lower := l.Points()
+	return New(upper.X(), upper.Y(), lower.X(), lower.Y())
+}
+
 // NewFromPoints creates a new Rectangle from four points.
 // The points can be provided in any order, but they must form an axis-aligned rectangle.
 //
@@ -114,6 +143,38 @@ func NewFromPoints(pt1, pt2, pt3, pt4 point.Point) Rectangle {
 	}
 }
 
+// BoundingBoxOfPoints returns the smallest axis-aligned Rectangle containing all the given points.
+//
+// Unlike [NewFromPoints], the points do not need to form a rectangle: any number of scattered points
+// may be given, including a single point or two points.
+//
+// Parameters:
+//   - points ([]point.Point): The points to enclose.
+//
+// Returns:
+//   - Rectangle: The axis-aligned bounding box of the points.
+//   - error: An error if no points are given.
+//
+// Behavior:
+//   - If all the points share an x or y coordinate, the resulting rectangle has zero width or height.
+func BoundingBoxOfPoints(points []point.Point) (Rectangle, error) {
+	if len(points) == 0 {
+		return Rectangle{}, fmt.Errorf("cannot compute the bounding box of an empty set of points")
+	}
+
+	minX, maxX := points[0].X(), points[0].X()
+	minY, maxY := points[0].Y(), points[0].Y()
+
+	for _, p := range points[1:] {
+		minX = min(minX, p.X())
+		minY = min(minY, p.Y())
+		maxX = max(maxX, p.X())
+		maxY = max(maxY, p.Y())
+	}
+
+	return New(minX, minY, maxX, maxY), nil
+}
+
 // Area calculates the area of the rectangle.
 //
 // Returns:
@@ -122,6 +183,113 @@ func (r Rectangle) Area() float64 {
 	return r.Width() * r.Height()
 }
 
+// BottomLeft returns the bottom-left corner of the Rectangle, which has the smallest x and y coordinates.
+//
+// Returns:
+//   - point.Point: The bottom-left corner.
+//
+// Notes:
+//   - Corners are named with the Y-axis pointing upwards. See [Rectangle].
+func (r Rectangle) BottomLeft() point.Point {
+	return r.bottomLeft
+}
+
+// BottomRight returns the bottom-right corner of the Rectangle, which has the greatest x-coordinate
+// and the smallest y-coordinate.
+//
+// Returns:
+//   - point.Point: The bottom-right corner.
+//
+// Notes:
+//   - Corners are named with the Y-axis pointing upwards. See [Rectangle].
+func (r Rectangle) BottomRight() point.Point {
+	return r.bottomRight
+}
+
+// BoundingBox returns the axis-aligned bounding box of the Rectangle, which is the Rectangle itself.
+//
+// This method allows [Rectangle] to satisfy the [Bounded] interface.
+//
+// Returns:
+//   - Rectangle: The rectangle.
+func (r Rectangle) BoundingBox() Rectangle {
+	return r
+}
+
+// Center returns the center point of the Rectangle, where its diagonals cross.
+//
+// Returns:
+//   - point.Point: The point midway between the bottom-left and top-right corners.
+func (r Rectangle) Center() point.Point {
+	return point.New(
+		(r.bottomLeft.X()+r.topRight.X())/2,
+		(r.bottomLeft.Y()+r.topRight.Y())/2,
+	)
+}
+
+// ClipLineSegment clips a [linesegment.LineSegment] to the Rectangle using the [Liang–Barsky] algorithm.
+//
+// Parameters:
+//   - l (linesegment.LineSegment): The line segment to clip.
+//
+// Returns:
+//   - linesegment.LineSegment: The portion of l that lies inside or on the boundary of the rectangle.
+//   - bool: true if any part of l lies inside or on the boundary of the rectangle, false otherwise.
+//
+// Behavior:
+//   - The segment is parameterised from its upper point (t = 0) to its lower point (t = 1), and the
+//     parameter range is narrowed against each of the four rectangle edges in turn.
+//   - If the segment lies entirely outside the rectangle, the function returns a zero-value
+//     [linesegment.LineSegment] and false.
+//   - A segment that only touches the boundary (for example, at a corner) is returned as a
+//     degenerate (zero-length) segment with true.
+//
+// [Liang–Barsky]: https://en.wikipedia.org/wiki/Liang%E2%80%93Barsky_algorithm
+func (r Rectangle) ClipLineSegment(l linesegment.LineSegment) (linesegment.LineSegment, bool) {
+	start, end := l.Points()
+	dx := end.X() - start.X()
+	dy := end.Y() - start.Y()
+
+	// p and q for the left, right, bottom and top edges respectively
+	p := [4]float64{-dx, dx, -dy, dy}
+	q := [4]float64{
+		start.X() - r.bottomLeft.X(),
+		r.topRight.X() - start.X(),
+		start.Y() - r.bottomLeft.Y(),
+		r.topRight.Y() - start.Y(),
+	}
+
+	t0, t1 := 0.0, 1.0
+	for i := range p {
+		if p[i] == 0 {
+			// Segment is parallel to this edge; reject if it lies outside of it
+			if q[i] < 0 {
+				return linesegment.LineSegment{}, false
+			}
+			continue
+		}
+		t := q[i] / p[i]
+		if p[i] < 0 {
+			// Entering
+			if t > t1 {
+				return linesegment.LineSegment{}, false
+			}
+			t0 = max(t0, t)
+		} else {
+			// Leaving
+			if t < t0 {
+				return linesegment.LineSegment{}, false
+			}
+			t1 = min(t1, t)
+		}
+	}
+
+	return linesegment.New(
+		start.X()+t0*dx, start.Y()+t0*dy,
+		start.X()+t1*dx, start.Y()+t1*dy,
+	), true
+}
+
 // ContainsPoint checks if a given point lies within or on the boundary of the Rectangle.
 //
 // Parameters:
@@ -141,6 +309,26 @@ func (r Rectangle) ContainsPoint(p point.Point) bool {
 		p.Y() >= r.bottomRight.Y()
 }
 
+// ContainsPointStrictly checks if a given point lies within the Rectangle, excluding its boundary.
+//
+// Parameters:
+//   - p: The [point.Point] to check.
+//
+// Returns:
+//   - bool: Returns true if the point lies strictly inside the rectangle, false if it lies on the boundary or outside.
+//
+// Behavior:
+//   - Like [Rectangle.ContainsPoint], this is a direct comparison against the rectangle's edges, so it is
+//     cheaper than [Rectangle.RelationshipToPoint] for callers that only need a boolean (such as hit-testing).
+//   - The rectangle's boundary is exclusive for both x and y coordinates, so this returns true exactly when
+//     [Rectangle.RelationshipToPoint] returns [types.RelationshipContainedBy].
+func (r Rectangle) ContainsPointStrictly(p point.Point) bool {
+	return p.X() > r.topLeft.X() &&
+		p.X() < r.bottomRight.X() &&
+		p.Y() < r.topLeft.Y() &&
+		p.Y() > r.bottomRight.Y()
+}
+
 // Contour returns the four corner points of the rectangle in the following order:
 // top-left, top-right, bottom-right, and bottom-left.
 //
@@ -233,6 +421,95 @@ func (r Rectangle) Height() float64 {
 	return height
 }
 
+// Inset shrinks the Rectangle by the same margin on all four sides.
+//
+// This is equivalent to calling [Rectangle.InsetEach] with the same margin for every side.
+//
+// Parameters:
+//   - margin (float64): The distance to move each edge towards the center. Negative values expand the rectangle.
+//
+// Returns:
+//   - Rectangle: A new rectangle with each edge moved inwards by margin.
+//
+// Behavior:
+//   - If the margin exceeds half the width or height, that dimension is clamped to zero at the rectangle's
+//     center rather than inverting. See [Rectangle.InsetEach].
+func (r Rectangle) Inset(margin float64) Rectangle {
+	return r.InsetEach(margin, margin, margin, margin)
+}
+
+// InsetEach shrinks the Rectangle by a separate margin on each side.
+//
+// Parameters:
+//   - top, right, bottom, left (float64): The distance to move each edge towards the center.
+//     Negative values move that edge outwards, expanding the rectangle.
+//
+// Returns:
+//   - Rectangle: A new rectangle with each edge moved inwards by its margin.
+//
+// Behavior:
+//   - If left + right exceeds the rectangle's width, the result has zero width, and its left and right edges
+//     are both placed at the horizontal center of the original rectangle.
+//   - Similarly, if top + bottom exceeds the rectangle's height, the result has zero height, and its top and bottom
+//     edges are both placed at the vertical center of the original rectangle.
+//   - The rectangle is therefore never inverted, which would otherwise happen when edges pass each other.
+func (r Rectangle) InsetEach(top, right, bottom, left float64) Rectangle {
+	minX, maxX := r.topLeft.X()+left, r.bottomRight.X()-right
+	if minX > maxX {
+		minX = (r.topLeft.X() + r.bottomRight.X()) / 2
+		maxX = minX
+	}
+
+	minY, maxY := r.bottomRight.Y()+bottom, r.topLeft.Y()-top
+	if minY > maxY {
+		minY = (r.bottomRight.Y() + r.topLeft.Y()) / 2
+		maxY = minY
+	}
+
+	return New(minX, minY, maxX, maxY)
+}
+
+// Map applies a coordinate transformation function to the four corners of the Rectangle.
+//
+// Parameters:
+//   - fn (func(point.Point) point.Point): The transformation to apply to each corner.
+//
+// Returns:
+//   - Rectangle: A new rectangle with the transformed corners.
+//   - error: An error if the transformed corners do not form an axis-aligned rectangle.
+//
+// Behavior:
+//   - Transformations such as translation, scaling (including non-uniform scaling), reflection across
+//     an axis, and rotation by multiples of 90° keep the rectangle axis-aligned, and succeed.
+//   - Other transformations, such as rotation by an arbitrary angle, generally do not, and return an error.
+//     For those, map each corner returned by [Rectangle.Contour] instead, to obtain the transformed polygon.
+//   - The global epsilon value is used when checking that the transformed corners are axis-aligned.
+func (r Rectangle) Map(fn func(point.Point) point.Point) (Rectangle, error) {
+	corners := []point.Point{fn(r.bottomLeft), fn(r.bottomRight), fn(r.topRight), fn(r.topLeft)}
+
+	bounds, err := BoundingBoxOfPoints(corners)
+	if err != nil {
+		return Rectangle{}, err
+	}
+
+	// each corner of the bounds must be one of the transformed corners
+	boundsBottomLeft, boundsBottomRight, boundsTopRight, boundsTopLeft := bounds.Contour()
+	for _, boundsCorner := range []point.Point{boundsBottomLeft, boundsBottomRight, boundsTopRight, boundsTopLeft} {
+		found := false
+		for _, corner := range corners {
+			if corner.Eq(boundsCorner) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return Rectangle{}, fmt.Errorf("transformed corners %v do not form an axis-aligned rectangle", corners)
+		}
+	}
+
+	return bounds, nil
+}
+
 // MarshalJSON serializes Rectangle as JSON while preserving its original type.
 func (r Rectangle) MarshalJSON() ([]byte, error) {
 	return json.Marshal(struct {
@@ -273,9 +550,13 @@ func (r Rectangle) Perimeter() float64 {
 //   - The function checks if the point lies on any of the rectangle's edges. If so, it returns [types.RelationshipIntersection].
 //   - If the point is not on an edge but is inside the rectangle, it returns [types.RelationshipContainedBy].
 //   - If the point is neither on an edge nor inside the rectangle, it returns [types.RelationshipDisjoint].
+//
+// Notes:
+//   - The result describes the point relative to the rectangle, so it already answers the point's
+//     side of the question: no [types.Relationship.FlipContainment] is needed.
 func (r Rectangle) RelationshipToPoint(p point.Point) types.Relationship {
 	for edge := range r.EdgesIter {
-		if edge.RelationshipToPoint(p) == types.RelationshipIntersection {
+		if edge.RelationshipToPoint(p) != types.RelationshipDisjoint {
 			return types.RelationshipIntersection
 		}
 	}
@@ -285,6 +566,53 @@ func (r Rectangle) RelationshipToPoint(p point.Point) types.Relationship {
 	return types.RelationshipDisjoint
 }
 
+// Rotate rotates the Rectangle counter-clockwise by a specified angle (in radians) around a given pivot [point.Point].
+//
+// A rectangle rotated by an arbitrary angle is no longer axis-aligned and so cannot be represented as a
+// [Rectangle]. Its rotated corners are returned instead.
+//
+// Parameters:
+//   - pivot (point.Point): The point around which to rotate the rectangle.
+//   - radians (float64): The angle of rotation, specified in radians.
+//
+// Returns:
+//   - []point.Point: The four rotated corners, in the order bottom-left, bottom-right, top-right, top-left
+//     of the original rectangle. As rotation preserves orientation, the corners remain in counterclockwise order.
+//
+// Notes:
+//   - The corners are rotated using [point.Point.Rotate].
+//   - For rotations by a multiple of 90°, use [Rectangle.RotateOrthogonal], which is exact and returns a [Rectangle].
+func (r Rectangle) Rotate(pivot point.Point, radians float64) []point.Point {
+	return []point.Point{
+		r.bottomLeft.Rotate(pivot, radians),
+		r.bottomRight.Rotate(pivot, radians),
+		r.topRight.Rotate(pivot, radians),
+		r.topLeft.Rotate(pivot, radians),
+	}
+}
+
+// RotateOrthogonal rotates the Rectangle counter-clockwise around a given pivot [point.Point]
+// by a whole number of quarter turns (90°).
+//
+// Parameters:
+//   - pivot (point.Point): The point around which to rotate the rectangle.
+//   - quarterTurns (int): The number of 90° counter-clockwise turns. Negative values rotate
+//     clockwise, and values outside [0, 3] are normalized modulo 4.
+//
+// Returns:
+//   - Rectangle: A new rectangle representing the rotated position.
+//
+// Notes:
+//   - A rectangle rotated by a multiple of 90° remains axis-aligned, so the result is always a valid [Rectangle].
+//     For odd numbers of quarter turns, the width and height are swapped.
+//   - The corners are rotated using [point.Point.RotateOrthogonal], so the result is exact and integer
+//     coordinates remain integers.
+func (r Rectangle) RotateOrthogonal(pivot point.Point, quarterTurns int) Rectangle {
+	a := r.bottomLeft.RotateOrthogonal(pivot, quarterTurns)
+	b := r.topRight.RotateOrthogonal(pivot, quarterTurns)
+	return New(a.X(), a.Y(), b.X(), b.Y())
+}
+
 // Scale scales the Rectangle relative to a specified reference point by a given scalar factor.
 //
 // Each corner of the rectangle is scaled relative to the reference point using the provided factor.
@@ -372,6 +700,19 @@ func (r Rectangle) ScaleWidth(factor float64) Rectangle {
 	)
 }
 
+// StringWithPrecision returns a string representation of the rectangle in the same format as [Rectangle.String],
+// with each coordinate printed using the given number of decimal places.
+//
+// Parameters:
+//   - decimals (int): The number of digits after the decimal point. If negative, the shortest
+//     representation that exactly represents each coordinate is used.
+//
+// Returns:
+//   - string: A formatted string showing the coordinates of the rectangle's corners, such as "[(0.0,0.0),(2.5,1.0)]".
+func (r Rectangle) StringWithPrecision(decimals int) string {
+	return "[" + r.bottomLeft.StringWithPrecision(decimals) + "," + r.topRight.StringWithPrecision(decimals) + "]"
+}
+
 // String returns a string representation of the rectangle.
 // The representation includes the coordinates of the rectangle's corners in counter-clockwise order,
 // in the format: "[(bottomLeft),(topRight)]".
@@ -399,6 +740,29 @@ func (r Rectangle) ToImageRect() image.Rectangle {
 	)
 }
 
+// TopLeft returns the top-left corner of the Rectangle, which has the smallest x-coordinate
+// and the greatest y-coordinate.
+//
+// Returns:
+//   - point.Point: The top-left corner.
+//
+// Notes:
+//   - Corners are named with the Y-axis pointing upwards. See [Rectangle].
+func (r Rectangle) TopLeft() point.Point {
+	return r.topLeft
+}
+
+// TopRight returns the top-right corner of the Rectangle, which has the greatest x and y coordinates.
+//
+// Returns:
+//   - point.Point: The top-right corner.
+//
+// Notes:
+//   - Corners are named with the Y-axis pointing upwards. See [Rectangle].
+func (r Rectangle) TopRight() point.Point {
+	return r.topRight
+}
+
 // Translate moves the rectangle by a specified vector.
 //
 // This method shifts the rectangle's position in the 2D plane by translating
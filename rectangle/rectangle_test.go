@@ -8,9 +8,54 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"image"
+	"math"
 	"testing"
 )
 
+func TestBoundingBoxOfPoints(t *testing.T) {
+	tests := map[string]struct {
+		points      []point.Point
+		expected    Rectangle
+		expectedErr bool
+	}{
+		"scattered points": {
+			points:   []point.Point{point.New(3, 1), point.New(-2, 4), point.New(5, -1), point.New(0, 0)},
+			expected: New(-2, -1, 5, 4),
+		},
+		"two points": {
+			points:   []point.Point{point.New(4, 4), point.New(1, 2)},
+			expected: New(1, 2, 4, 4),
+		},
+		"single point": {
+			points:   []point.Point{point.New(2, 3)},
+			expected: New(2, 3, 2, 3),
+		},
+		"collinear points": {
+			points:   []point.Point{point.New(0, 1), point.New(5, 1), point.New(2, 1)},
+			expected: New(0, 1, 5, 1),
+		},
+		"empty": {
+			points:      nil,
+			expectedErr: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			actual, err := BoundingBoxOfPoints(tc.points)
+			if tc.expectedErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, tc.expected.Eq(actual), "expected %s, got %s", tc.expected, actual)
+			for _, p := range tc.points {
+				assert.True(t, actual.ContainsPoint(p))
+			}
+		})
+	}
+}
+
 func TestNewFromImageRect(t *testing.T) {
 	tests := map[string]struct {
 		imageRect image.Rectangle
@@ -52,6 +97,32 @@ func TestNewFromImageRect(t *testing.T) {
 	}
 }
 
+func TestNewFromLineSegment(t *testing.T) {
+	tests := map[string]struct {
+		segment  linesegment.LineSegment
+		expected Rectangle
+	}{
+		"diagonal segment": {
+			segment:  linesegment.New(0, 10, 5, 0),
+			expected: New(0, 0, 5, 10),
+		},
+		"horizontal segment": {
+			segment:  linesegment.New(5, 2, -5, 2),
+			expected: New(-5, 2, 5, 2),
+		},
+		"degenerate segment": {
+			segment:  linesegment.New(1, 1, 1, 1),
+			expected: New(1, 1, 1, 1),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, NewFromLineSegment(tc.segment))
+		})
+	}
+}
+
 func TestRectangle_Area(t *testing.T) {
 	tests := map[string]struct {
 		rect     Rectangle
@@ -83,6 +154,109 @@ func TestRectangle_Area(t *testing.T) {
 	}
 }
 
+func TestRectangle_BoundingBox(t *testing.T) {
+	r := New(1, 2, 3, 4)
+	var bounded Bounded = r
+	assert.Equal(t, r, bounded.BoundingBox())
+}
+
+func TestRectangle_Center(t *testing.T) {
+	tests := map[string]struct {
+		rect     Rectangle
+		expected point.Point
+	}{
+		"even size": {
+			rect:     New(0, 0, 10, 4),
+			expected: point.New(5, 2),
+		},
+		"odd size": {
+			rect:     New(0, 0, 3, 5),
+			expected: point.New(1.5, 2.5),
+		},
+		"negative coordinates": {
+			rect:     New(-4, -6, -2, 0),
+			expected: point.New(-3, -3),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.rect.Center())
+		})
+	}
+}
+
+func TestRectangle_ClipLineSegment(t *testing.T) {
+	tests := map[string]struct {
+		rect         Rectangle
+		segment      linesegment.LineSegment
+		expected     linesegment.LineSegment
+		expectInside bool
+	}{
+		"segment fully inside": {
+			rect:         New(0, 0, 10, 10),
+			segment:      linesegment.New(2, 2, 8, 8),
+			expected:     linesegment.New(2, 2, 8, 8),
+			expectInside: true,
+		},
+		"segment fully outside": {
+			rect:         New(0, 0, 10, 10),
+			segment:      linesegment.New(12, 0, 20, 10),
+			expectInside: false,
+		},
+		"segment crossing one edge": {
+			rect:         New(0, 0, 10, 10),
+			segment:      linesegment.New(5, 5, 15, 5),
+			expected:     linesegment.New(5, 5, 10, 5),
+			expectInside: true,
+		},
+		"segment crossing two edges": {
+			rect:         New(0, 0, 10, 10),
+			segment:      linesegment.New(-5, 5, 15, 5),
+			expected:     linesegment.New(0, 5, 10, 5),
+			expectInside: true,
+		},
+		"diagonal segment through corners": {
+			rect:         New(0, 0, 10, 10),
+			segment:      linesegment.New(-5, -5, 15, 15),
+			expected:     linesegment.New(0, 0, 10, 10),
+			expectInside: true,
+		},
+		"segment parallel to edge outside": {
+			rect:         New(0, 0, 10, 10),
+			segment:      linesegment.New(-5, 11, 15, 11),
+			expectInside: false,
+		},
+		"segment along edge": {
+			rect:         New(0, 0, 10, 10),
+			segment:      linesegment.New(-5, 10, 15, 10),
+			expected:     linesegment.New(0, 10, 10, 10),
+			expectInside: true,
+		},
+		"segment touching corner": {
+			rect:         New(0, 0, 10, 10),
+			segment:      linesegment.New(10, 10, 15, 5),
+			expected:     linesegment.New(10, 10, 10, 10),
+			expectInside: true,
+		},
+		"segment missing the rectangle diagonally": {
+			rect:         New(0, 0, 10, 10),
+			segment:      linesegment.New(8, 15, 15, 8),
+			expectInside: false,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			actual, inside := tc.rect.ClipLineSegment(tc.segment)
+			require.Equal(t, tc.expectInside, inside)
+			if tc.expectInside {
+				assert.True(t, tc.expected.Eq(actual), "expected %s, got %s", tc.expected, actual)
+			}
+		})
+	}
+}
+
 func TestRectangle_ContainsPoint(t *testing.T) {
 	tests := map[string]struct {
 		rect     Rectangle
@@ -129,6 +303,53 @@ func TestRectangle_ContainsPoint(t *testing.T) {
 	}
 }
 
+func TestRectangle_ContainsPointStrictly(t *testing.T) {
+	tests := map[string]struct {
+		rect     Rectangle
+		point    point.Point
+		expected bool
+	}{
+		"point inside rectangle": {
+			rect:     New(0, 0, 10, 20),
+			point:    point.New(5, 10),
+			expected: true,
+		},
+		"point on corner": {
+			rect:     New(0, 0, 10, 20),
+			point:    point.New(10, 20),
+			expected: false,
+		},
+		"point on horizontal edge": {
+			rect:     New(0, 0, 10, 20),
+			point:    point.New(5, 0),
+			expected: false,
+		},
+		"point on vertical edge": {
+			rect:     New(0, 0, 10, 20),
+			point:    point.New(10, 5),
+			expected: false,
+		},
+		"point just inside edge": {
+			rect:     New(0, 0, 10, 20),
+			point:    point.New(1e-9, 5),
+			expected: true,
+		},
+		"point outside rectangle": {
+			rect:     New(0, 0, 10, 20),
+			point:    point.New(15, 10),
+			expected: false,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			actual := tt.rect.ContainsPointStrictly(tt.point)
+			assert.Equal(t, tt.expected, actual)
+			assert.Equal(t, tt.rect.RelationshipToPoint(tt.point) == types.RelationshipContainedBy, actual)
+		})
+	}
+}
+
 func TestRectangle_Contour(t *testing.T) {
 	// Define a rectangle with specific corners
 	bottomLeft := point.New(0, 0)
@@ -148,6 +369,23 @@ func TestRectangle_Contour(t *testing.T) {
 	assert.Equal(t, topLeft, tl, "top-left corner mismatch")
 }
 
+func TestRectangle_Corners(t *testing.T) {
+	// corners may be given in any order
+	rect := New(10, 3, -2, 7)
+
+	assert.Equal(t, point.New(-2, 3), rect.BottomLeft())
+	assert.Equal(t, point.New(10, 3), rect.BottomRight())
+	assert.Equal(t, point.New(-2, 7), rect.TopLeft())
+	assert.Equal(t, point.New(10, 7), rect.TopRight())
+
+	// accessors agree with Contour
+	bottomLeft, bottomRight, topRight, topLeft := rect.Contour()
+	assert.Equal(t, bottomLeft, rect.BottomLeft())
+	assert.Equal(t, bottomRight, rect.BottomRight())
+	assert.Equal(t, topRight, rect.TopRight())
+	assert.Equal(t, topLeft, rect.TopLeft())
+}
+
 func TestRectangle_Edges(t *testing.T) {
 	// Define a rectangle with specific corners
 	bottomLeft := point.New(0, 0)
@@ -274,6 +512,152 @@ func TestRectangle_Height(t *testing.T) {
 	}
 }
 
+func TestRectangle_Inset(t *testing.T) {
+	tests := map[string]struct {
+		rect     Rectangle
+		margin   float64
+		expected Rectangle
+	}{
+		"shrink": {
+			rect:     New(0, 0, 10, 6),
+			margin:   1,
+			expected: New(1, 1, 9, 5),
+		},
+		"expand with negative margin": {
+			rect:     New(0, 0, 10, 6),
+			margin:   -2,
+			expected: New(-2, -2, 12, 8),
+		},
+		"zero margin": {
+			rect:     New(0, 0, 10, 6),
+			margin:   0,
+			expected: New(0, 0, 10, 6),
+		},
+		"exactly half the height collapses height": {
+			rect:     New(0, 0, 10, 6),
+			margin:   3,
+			expected: New(3, 3, 7, 3),
+		},
+		"over-inset height clamps to center": {
+			rect:     New(0, 0, 10, 6),
+			margin:   4,
+			expected: New(4, 3, 6, 3),
+		},
+		"over-inset both dimensions clamps to center point": {
+			rect:     New(0, 0, 10, 6),
+			margin:   100,
+			expected: New(5, 3, 5, 3),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			actual := tc.rect.Inset(tc.margin)
+			assert.True(t, tc.expected.Eq(actual), "expected %s, got %s", tc.expected, actual)
+		})
+	}
+}
+
+func TestRectangle_InsetEach(t *testing.T) {
+	tests := map[string]struct {
+		rect                     Rectangle
+		top, right, bottom, left float64
+		expected                 Rectangle
+	}{
+		"different margins": {
+			rect:     New(0, 0, 10, 10),
+			top:      1,
+			right:    2,
+			bottom:   3,
+			left:     4,
+			expected: New(4, 3, 8, 9),
+		},
+		"mixed shrink and expand": {
+			rect:     New(0, 0, 10, 10),
+			top:      -1,
+			right:    2,
+			bottom:   0,
+			left:     -3,
+			expected: New(-3, 0, 8, 11),
+		},
+		"over-inset horizontally clamps to horizontal center": {
+			rect:     New(0, 0, 10, 10),
+			top:      1,
+			right:    9,
+			bottom:   1,
+			left:     2,
+			expected: New(5, 1, 5, 9),
+		},
+		"over-inset vertically clamps to vertical center": {
+			rect:     New(0, 0, 10, 10),
+			top:      8,
+			right:    1,
+			bottom:   8,
+			left:     1,
+			expected: New(1, 5, 9, 5),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			actual := tc.rect.InsetEach(tc.top, tc.right, tc.bottom, tc.left)
+			assert.True(t, tc.expected.Eq(actual), "expected %s, got %s", tc.expected, actual)
+		})
+	}
+}
+
+func TestRectangle_Map(t *testing.T) {
+	tests := map[string]struct {
+		rect        Rectangle
+		fn          func(point.Point) point.Point
+		expected    Rectangle
+		expectedErr bool
+	}{
+		"translate": {
+			rect:     New(0, 0, 4, 2),
+			fn:       func(p point.Point) point.Point { return p.Translate(point.New(1, -1)) },
+			expected: New(1, -1, 5, 1),
+		},
+		"non-uniform scale": {
+			rect:     New(1, 1, 2, 2),
+			fn:       func(p point.Point) point.Point { return point.New(p.X()*3, p.Y()*0.5) },
+			expected: New(3, 0.5, 6, 1),
+		},
+		"reflect across Y-axis": {
+			rect:     New(1, 0, 3, 2),
+			fn:       func(p point.Point) point.Point { return point.New(-p.X(), p.Y()) },
+			expected: New(-3, 0, -1, 2),
+		},
+		"rotate by 90 degrees": {
+			rect:     New(0, 0, 4, 2),
+			fn:       func(p point.Point) point.Point { return p.Rotate(point.Origin(), math.Pi/2) },
+			expected: New(-2, 0, 0, 4),
+		},
+		"rotate by 45 degrees is not axis-aligned": {
+			rect:        New(0, 0, 4, 2),
+			fn:          func(p point.Point) point.Point { return p.Rotate(point.Origin(), math.Pi/4) },
+			expectedErr: true,
+		},
+		"collapse onto a diagonal is not axis-aligned": {
+			rect:        New(0, 0, 4, 2),
+			fn:          func(p point.Point) point.Point { return point.New(p.X(), p.X()) },
+			expectedErr: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			actual, err := tc.rect.Map(tc.fn)
+			if tc.expectedErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, tc.expected.Eq(actual), "expected %s, got %s", tc.expected, actual)
+		})
+	}
+}
+
 func TestRectangle_MarshalUnmarshalJSON(t *testing.T) {
 	tests := map[string]struct {
 		rectangle Rectangle // Input rectangle
@@ -368,6 +752,84 @@ func TestRectangle_RelationshipToPoint(t *testing.T) {
 	}
 }
 
+func TestRectangle_Rotate(t *testing.T) {
+	tests := map[string]struct {
+		rect     Rectangle
+		pivot    point.Point
+		radians  float64
+		expected []point.Point
+	}{
+		"quarter turn around origin": {
+			rect:     New(1, 0, 4, 2),
+			pivot:    point.New(0, 0),
+			radians:  math.Pi / 2,
+			expected: []point.Point{point.New(0, 1), point.New(0, 4), point.New(-2, 4), point.New(-2, 1)},
+		},
+		"eighth turn around centre": {
+			rect:    New(0, 0, 2, 2),
+			pivot:   point.New(1, 1),
+			radians: math.Pi / 4,
+			expected: []point.Point{
+				point.New(1, 1-math.Sqrt2),
+				point.New(1+math.Sqrt2, 1),
+				point.New(1, 1+math.Sqrt2),
+				point.New(1-math.Sqrt2, 1),
+			},
+		},
+		"no rotation": {
+			rect:     New(0, 0, 3, 1),
+			pivot:    point.New(5, 5),
+			radians:  0,
+			expected: []point.Point{point.New(0, 0), point.New(3, 0), point.New(3, 1), point.New(0, 1)},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			actual := tc.rect.Rotate(tc.pivot, tc.radians)
+			require.Len(t, actual, len(tc.expected))
+			for i := range tc.expected {
+				assert.True(t, tc.expected[i].Eq(actual[i]), "expected %v, got %v", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestRectangle_RotateOrthogonal(t *testing.T) {
+	tests := map[string]struct {
+		rect         Rectangle
+		pivot        point.Point
+		quarterTurns int
+		expected     Rectangle
+	}{
+		"one turn around origin swaps width and height": {
+			rect:         New(1, 0, 4, 2),
+			pivot:        point.New(0, 0),
+			quarterTurns: 1,
+			expected:     New(-2, 1, 0, 4),
+		},
+		"two turns around centre": {
+			rect:         New(0, 0, 4, 2),
+			pivot:        point.New(2, 1),
+			quarterTurns: 2,
+			expected:     New(0, 0, 4, 2),
+		},
+		"negative turn around custom pivot": {
+			rect:         New(1, 1, 3, 2),
+			pivot:        point.New(1, 1),
+			quarterTurns: -1,
+			expected:     New(1, 1, 2, -1),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			actual := tc.rect.RotateOrthogonal(tc.pivot, tc.quarterTurns)
+			assert.True(t, tc.expected.Eq(actual), "expected %s, got %s", tc.expected, actual)
+		})
+	}
+}
+
 func TestRectangle_Scale(t *testing.T) {
 	tests := map[string]struct {
 		rect     Rectangle
@@ -499,6 +961,36 @@ func TestRectangle_String(t *testing.T) {
 	}
 }
 
+func TestRectangle_StringWithPrecision(t *testing.T) {
+	tests := map[string]struct {
+		rect     Rectangle
+		decimals int
+		expected string
+	}{
+		"one decimal": {
+			rect:     New(0, 0, 2.55, 1.04),
+			decimals: 1,
+			expected: "[(0.0,0.0),(2.5,1.0)]",
+		},
+		"zero decimals": {
+			rect:     New(-3, -2, 2, 1),
+			decimals: 0,
+			expected: "[(-3,-2),(2,1)]",
+		},
+		"negative decimals uses shortest representation": {
+			rect:     New(0, 0, 0.1, 0.25),
+			decimals: -1,
+			expected: "[(0,0),(0.1,0.25)]",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.rect.StringWithPrecision(tt.decimals))
+		})
+	}
+}
+
 func TestRectangle_ToImageRect(t *testing.T) {
 	rect := New(0, 0, 100, 200)
 	expected := image.Rect(0, 0, 100, 200)
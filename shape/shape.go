@@ -0,0 +1,111 @@
+// Package shape defines the Shape interface, a common method set shared by the geometric types in the geom2d library.
+//
+// # Overview
+//
+// Each geometric type in geom2d lives in its own package and exposes methods suited to that type.
+// The [Shape] interface captures the subset of behaviour that makes sense for every type, so that
+// heterogeneous shapes can be stored in a single slice and processed uniformly.
+//
+// The following types satisfy [Shape]:
+//   - circle.Circle and rectangle.Rectangle satisfy [Shape] directly.
+//   - point.Point and linesegment.LineSegment cannot implement BoundingBox themselves (the rectangle
+//     package depends on both), so they are adapted using [FromPoint] and [FromLineSegment].
+//
+// For shapes without an interior, the degenerate values are used:
+//   - A point has an area and perimeter of 0.
+//   - A line segment has an area of 0, and its perimeter is its length.
+package shape
+
+import (
+	"github.com/mikenye/geom2d/circle"
+	"github.com/mikenye/geom2d/linesegment"
+	"github.com/mikenye/geom2d/point"
+	"github.com/mikenye/geom2d/rectangle"
+	"github.com/mikenye/geom2d/types"
+)
+
+// Compile-time checks that the concrete types satisfy Shape.
+var (
+	_ Shape = circle.Circle{}
+	_ Shape = rectangle.Rectangle{}
+	_ Shape = pointShape{}
+	_ Shape = lineSegmentShape{}
+)
+
+// Shape is the common interface implemented by all geometric types in geom2d.
+type Shape interface {
+	rectangle.Bounded
+
+	// Area returns the area enclosed by the shape.
+	Area() float64
+
+	// Perimeter returns the length of the shape's boundary.
+	Perimeter() float64
+
+	// RelationshipToPoint returns the spatial relationship between the shape and a point.
+	RelationshipToPoint(p point.Point) types.Relationship
+}
+
+// FromLineSegment adapts a [linesegment.LineSegment] to the [Shape] interface.
+//
+// Parameters:
+//   - l (linesegment.LineSegment): The line segment to adapt.
+//
+// Returns:
+//   - Shape: A Shape whose BoundingBox is [rectangle.NewFromLineSegment], whose Area is 0,
+//     whose Perimeter is the length of l, and whose RelationshipToPoint delegates to l.
+func FromLineSegment(l linesegment.LineSegment) Shape {
+	return lineSegmentShape{l}
+}
+
+// FromPoint adapts a [point.Point] to the [Shape] interface.
+//
+// Parameters:
+//   - p (point.Point): The point to adapt.
+//
+// Returns:
+//   - Shape: A Shape whose BoundingBox is a degenerate rectangle at p, whose Area and Perimeter
+//     are 0, and whose RelationshipToPoint delegates to p.
+func FromPoint(p point.Point) Shape {
+	return pointShape{p}
+}
+
+// lineSegmentShape adapts a linesegment.LineSegment to the Shape interface.
+type lineSegmentShape struct {
+	linesegment.LineSegment
+}
+
+// Area returns 0, as a line segment encloses no area.
+func (l lineSegmentShape) Area() float64 {
+	return 0
+}
+
+// BoundingBox returns the axis-aligned bounding box of the line segment.
+func (l lineSegmentShape) BoundingBox() rectangle.Rectangle {
+	return rectangle.NewFromLineSegment(l.LineSegment)
+}
+
+// Perimeter returns the length of the line segment.
+func (l lineSegmentShape) Perimeter() float64 {
+	return l.Length()
+}
+
+// pointShape adapts a point.Point to the Shape interface.
+type pointShape struct {
+	point.Point
+}
+
+// Area returns 0, as a point encloses no area.
+func (p pointShape) Area() float64 {
+	return 0
+}
+
+// BoundingBox returns a degenerate rectangle located at the point.
+func (p pointShape) BoundingBox() rectangle.Rectangle {
+	return rectangle.New(p.X(), p.Y(), p.X(), p.Y())
+}
+
+// Perimeter returns 0, as a point has no boundary length.
+func (p pointShape) Perimeter() float64 {
+	return 0
+}
@@ -0,0 +1,66 @@
+package shape
+
+import (
+	"github.com/mikenye/geom2d"
+	"github.com/mikenye/geom2d/circle"
+	"github.com/mikenye/geom2d/linesegment"
+	"github.com/mikenye/geom2d/point"
+	"github.com/mikenye/geom2d/rectangle"
+	"github.com/mikenye/geom2d/types"
+	"github.com/stretchr/testify/assert"
+	"math"
+	"testing"
+)
+
+func TestShape(t *testing.T) {
+	tests := map[string]struct {
+		shape             Shape
+		expectedBBox      rectangle.Rectangle
+		expectedArea      float64
+		expectedPerimeter float64
+		testPoint         point.Point
+		expectedRel       types.Relationship
+	}{
+		"point": {
+			shape:             FromPoint(point.New(1, 2)),
+			expectedBBox:      rectangle.New(1, 2, 1, 2),
+			expectedArea:      0,
+			expectedPerimeter: 0,
+			testPoint:         point.New(1, 2),
+			expectedRel:       types.RelationshipEqual,
+		},
+		"line segment": {
+			shape:             FromLineSegment(linesegment.New(0, 0, 3, 4)),
+			expectedBBox:      rectangle.New(0, 0, 3, 4),
+			expectedArea:      0,
+			expectedPerimeter: 5,
+			testPoint:         point.New(10, 10),
+			expectedRel:       types.RelationshipDisjoint,
+		},
+		"circle": {
+			shape:             circle.New(0, 0, 2),
+			expectedBBox:      rectangle.New(-2, -2, 2, 2),
+			expectedArea:      4 * math.Pi,
+			expectedPerimeter: 4 * math.Pi,
+			testPoint:         point.New(1, 0),
+			expectedRel:       types.RelationshipContainedBy,
+		},
+		"rectangle": {
+			shape:             rectangle.New(0, 0, 4, 3),
+			expectedBBox:      rectangle.New(0, 0, 4, 3),
+			expectedArea:      12,
+			expectedPerimeter: 14,
+			testPoint:         point.New(4, 1),
+			expectedRel:       types.RelationshipIntersection,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.True(t, tc.expectedBBox.Eq(tc.shape.BoundingBox()), "expected bounding box %s, got %s", tc.expectedBBox, tc.shape.BoundingBox())
+			assert.InDelta(t, tc.expectedArea, tc.shape.Area(), geom2d.GetEpsilon())
+			assert.InDelta(t, tc.expectedPerimeter, tc.shape.Perimeter(), geom2d.GetEpsilon())
+			assert.Equal(t, tc.expectedRel, tc.shape.RelationshipToPoint(tc.testPoint))
+		})
+	}
+}
@@ -60,7 +60,7 @@ const (
 // Example:
 //
 //	rel := RelationshipContainedBy
-//	flipped := rel.flipContainment()
+//	flipped := rel.FlipContainment()
 //	fmt.Println(flipped) // Output: RelationshipContains
 func (r Relationship) FlipContainment() Relationship {
 	switch r {
@@ -0,0 +1,44 @@
+package types
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestRelationship_FlipContainment(t *testing.T) {
+	tests := map[Relationship]Relationship{
+		RelationshipDisjoint:     RelationshipDisjoint,
+		RelationshipIntersection: RelationshipIntersection,
+		RelationshipContainedBy:  RelationshipContains,
+		RelationshipContains:     RelationshipContainedBy,
+		RelationshipEqual:        RelationshipEqual,
+	}
+
+	for rel, expected := range tests {
+		t.Run(rel.String(), func(t *testing.T) {
+			assert.Equal(t, expected, rel.FlipContainment())
+			assert.Equal(t, rel, rel.FlipContainment().FlipContainment(), "flipping twice should be a no-op")
+		})
+	}
+}
+
+func TestRelationship_String(t *testing.T) {
+	tests := map[Relationship]string{
+		RelationshipDisjoint:     "RelationshipDisjoint",
+		RelationshipIntersection: "RelationshipIntersection",
+		RelationshipContainedBy:  "RelationshipContainedBy",
+		RelationshipContains:     "RelationshipContains",
+		RelationshipEqual:        "RelationshipEqual",
+	}
+
+	// every value up to the last defined constant must have a name
+	for rel := RelationshipDisjoint; rel <= RelationshipEqual; rel++ {
+		t.Run(tests[rel], func(t *testing.T) {
+			assert.Equal(t, tests[rel], rel.String())
+		})
+	}
+
+	assert.Panics(t, func() {
+		_ = (RelationshipEqual + 1).String()
+	})
+}
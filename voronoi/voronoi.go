@@ -0,0 +1,112 @@
+// Package voronoi computes Voronoi diagrams of point sets, for spatial partitioning.
+//
+// # Overview
+//
+// The Voronoi diagram of a set of sites divides the plane into cells, one per site, so that every point in
+// a cell is at least as close to that cell's site as to any other site. As the diagram extends infinitely,
+// the cells are clipped to a bounding [rectangle.Rectangle].
+//
+// # Features
+//
+//   - Diagram computes the clipped Voronoi cell of every site.
+//
+// # Notes
+//
+//   - Each cell is computed by clipping the bounding rectangle against the perpendicular bisector of its site
+//     and every other site. This is simple and robust, but takes O(n²) time for n sites, so is best suited to
+//     modest numbers of sites.
+package voronoi
+
+import (
+	"cmp"
+	"fmt"
+	"github.com/mikenye/geom2d/point"
+	"github.com/mikenye/geom2d/rectangle"
+)
+
+// Diagram computes the Voronoi diagram of a set of sites, clipped to a rectangle.
+//
+// Parameters:
+//   - sites ([]point.Point): The sites of the diagram. The slice is not modified.
+//   - bounds (rectangle.Rectangle): The rectangle to which the cells are clipped.
+//
+// Returns:
+//   - [][]point.Point: The vertices of each site's cell, in the same order as sites. Each cell is a convex
+//     polygon in counterclockwise order, starting from its vertex with the lowest Y-coordinate (and the lowest
+//     X-coordinate, if there is more than one). The first point is not repeated at the end.
+//   - error: An error if no sites are given, if bounds has zero area, if a site lies outside bounds,
+//     or if two sites are equal.
+//
+// Behavior:
+//   - Every cell contains its own site, and together the cells cover bounds without overlapping.
+//   - The cell of a single site is the whole of bounds.
+func Diagram(sites []point.Point, bounds rectangle.Rectangle) ([][]point.Point, error) {
+	if len(sites) == 0 {
+		return nil, fmt.Errorf("at least 1 site is required to compute a Voronoi diagram")
+	}
+	if bounds.Area() == 0 {
+		return nil, fmt.Errorf("bounds %s must have a non-zero area", bounds)
+	}
+	for i, site := range sites {
+		if !bounds.ContainsPoint(site) {
+			return nil, fmt.Errorf("site %d %s lies outside bounds %s", i, site, bounds)
+		}
+		for j := 0; j < i; j++ {
+			if sites[j].Eq(site) {
+				return nil, fmt.Errorf("sites %d and %d are equal: %s", j, i, site)
+			}
+		}
+	}
+
+	bottomLeft, bottomRight, topRight, topLeft := bounds.Contour()
+
+	cells := make([][]point.Point, len(sites))
+	for i, site := range sites {
+		cell := []point.Point{bottomLeft, bottomRight, topRight, topLeft}
+		for j, other := range sites {
+			if i == j {
+				continue
+			}
+			cell = clipToBisector(cell, site, other)
+		}
+		cells[i] = rotateToLowest(cell)
+	}
+	return cells, nil
+}
+
+// clipToBisector clips a convex polygon to the closed half-plane of points at least as close to site as to other,
+// using the Sutherland–Hodgman algorithm.
+func clipToBisector(polygon []point.Point, site, other point.Point) []point.Point {
+	normal := other.Sub(site)
+	midpoint := point.New((site.X()+other.X())/2, (site.Y()+other.Y())/2)
+
+	// side is positive for points closer to other than to site
+	side := func(p point.Point) float64 {
+		return p.Sub(midpoint).DotProduct(normal)
+	}
+
+	clipped := make([]point.Point, 0, len(polygon)+1)
+	for k, p := range polygon {
+		q := polygon[(k+1)%len(polygon)]
+		sp, sq := side(p), side(q)
+		if sp <= 0 {
+			clipped = append(clipped, p)
+		}
+		if (sp < 0 && sq > 0) || (sp > 0 && sq < 0) {
+			t := sp / (sp - sq)
+			clipped = append(clipped, p.Add(q.Sub(p).Scale(point.Origin(), t)))
+		}
+	}
+	return clipped
+}
+
+// rotateToLowest rotates a polygon to begin at its vertex with the lowest y-coordinate, then lowest x-coordinate.
+func rotateToLowest(polygon []point.Point) []point.Point {
+	start := 0
+	for k, p := range polygon {
+		if cmp.Or(cmp.Compare(p.Y(), polygon[start].Y()), cmp.Compare(p.X(), polygon[start].X())) < 0 {
+			start = k
+		}
+	}
+	return append(polygon[start:], polygon[:start]...)
+}
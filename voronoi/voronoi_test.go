@@ -0,0 +1,122 @@
+package voronoi
+
+import (
+	"github.com/mikenye/geom2d"
+	"github.com/mikenye/geom2d/point"
+	"github.com/mikenye/geom2d/rectangle"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestDiagram(t *testing.T) {
+	tests := map[string]struct {
+		sites    []point.Point
+		bounds   rectangle.Rectangle
+		expected [][]point.Point
+	}{
+		"single site": {
+			sites:  []point.Point{point.New(1, 1)},
+			bounds: rectangle.New(0, 0, 4, 2),
+			expected: [][]point.Point{
+				{point.New(0, 0), point.New(4, 0), point.New(4, 2), point.New(0, 2)},
+			},
+		},
+		"two sites split vertically": {
+			sites:  []point.Point{point.New(3, 1), point.New(1, 1)},
+			bounds: rectangle.New(0, 0, 4, 2),
+			expected: [][]point.Point{
+				{point.New(2, 0), point.New(4, 0), point.New(4, 2), point.New(2, 2)},
+				{point.New(0, 0), point.New(2, 0), point.New(2, 2), point.New(0, 2)},
+			},
+		},
+		"two sites split diagonally": {
+			sites:  []point.Point{point.New(0, 0), point.New(2, 2)},
+			bounds: rectangle.New(0, 0, 2, 2),
+			expected: [][]point.Point{
+				{point.New(0, 0), point.New(2, 0), point.New(0, 2)},
+				{point.New(2, 0), point.New(2, 2), point.New(0, 2)},
+			},
+		},
+		"four sites in quadrants": {
+			sites:  []point.Point{point.New(1, 1), point.New(3, 1), point.New(3, 3), point.New(1, 3)},
+			bounds: rectangle.New(0, 0, 4, 4),
+			expected: [][]point.Point{
+				{point.New(0, 0), point.New(2, 0), point.New(2, 2), point.New(0, 2)},
+				{point.New(2, 0), point.New(4, 0), point.New(4, 2), point.New(2, 2)},
+				{point.New(2, 2), point.New(4, 2), point.New(4, 4), point.New(2, 4)},
+				{point.New(0, 2), point.New(2, 2), point.New(2, 4), point.New(0, 4)},
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			actual, err := Diagram(tc.sites, tc.bounds)
+			require.NoError(t, err)
+			require.Len(t, actual, len(tc.expected))
+			for i := range tc.expected {
+				require.Len(t, actual[i], len(tc.expected[i]), "cell %d: got %v", i, actual[i])
+				for k := range tc.expected[i] {
+					assert.True(t, tc.expected[i][k].Eq(actual[i][k]), "cell %d: expected %v, got %v", i, tc.expected[i], actual[i])
+				}
+			}
+		})
+	}
+}
+
+func TestDiagram_Partition(t *testing.T) {
+	sites := []point.Point{
+		point.New(1, 7), point.New(2, 2), point.New(5, 5), point.New(8, 1),
+		point.New(9, 9), point.New(4, 8), point.New(6, 3), point.New(0.5, 4),
+	}
+	bounds := rectangle.New(0, 0, 10, 10)
+
+	cells, err := Diagram(sites, bounds)
+	require.NoError(t, err)
+	require.Len(t, cells, len(sites))
+
+	var totalArea float64
+	for i, cell := range cells {
+		totalArea += point.PolygonArea(cell)
+
+		// each cell is convex, counterclockwise and contains its own site
+		for k := range cell {
+			a, b := cell[k], cell[(k+1)%len(cell)]
+			assert.NotEqual(t, point.Clockwise, point.Orientation(a, b, cell[(k+2)%len(cell)]), "cell %d is not convex and counterclockwise", i)
+			assert.NotEqual(t, point.Clockwise, point.Orientation(a, b, sites[i]), "cell %d does not contain its site", i)
+		}
+
+		// every vertex of the cell is at least as close to its site as to any other site
+		for _, v := range cell {
+			for j, other := range sites {
+				assert.LessOrEqual(t, v.DistanceToPoint(sites[i]), v.DistanceToPoint(other)+1e-9, "cell %d vertex %s is closer to site %d", i, v, j)
+			}
+		}
+	}
+
+	// the cells cover the bounds without overlapping
+	assert.InDelta(t, bounds.Area(), totalArea, 1e-9)
+}
+
+func TestDiagram_Errors(t *testing.T) {
+	bounds := rectangle.New(0, 0, 10, 10)
+
+	tests := map[string]struct {
+		sites  []point.Point
+		bounds rectangle.Rectangle
+	}{
+		"no sites":             {sites: nil, bounds: bounds},
+		"zero-area bounds":     {sites: []point.Point{point.New(0, 0)}, bounds: rectangle.New(0, 0, 0, 10)},
+		"site outside bounds":  {sites: []point.Point{point.New(5, 5), point.New(11, 5)}, bounds: bounds},
+		"duplicate sites":      {sites: []point.Point{point.New(5, 5), point.New(1, 1), point.New(5, 5)}, bounds: bounds},
+		"near-duplicate sites": {sites: []point.Point{point.New(5, 5), point.New(5, 5+geom2d.GetEpsilon()/2)}, bounds: bounds},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := Diagram(tc.sites, tc.bounds)
+			assert.Error(t, err)
+		})
+	}
+}